@@ -8,25 +8,33 @@ import (
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jprobinson/gtfs"
+	"github.com/jprobinson/gtfs/static"
 )
 
 func main() {
-	stops := writeRoutes()
+	feed, err := static.LoadDir("../../static_gtfs")
+	if err != nil {
+		fmt.Println("unable to load static GTFS:", err)
+		os.Exit(1)
+	}
+	stops := writeRoutes(feed)
 	writeSynonyms(stops)
 	writeStopLookup(stops)
 }
 
-func writeRoutes() map[string]gtfs.Route {
-	// trip ID => route ID
-	routesByTrip := getRoutesByTrip()
-	// train -> trip -> stop ID
-	tripsByRoute := getTripStops(routesByTrip)
+func writeRoutes(feed *static.Feed) map[string]gtfs.Route {
+	// train -> stop patterns
+	patterns := getPatterns(feed)
+	// train -> stop IDs
+	tripsByRoute := getRouteStops(feed, patterns)
 	// add metadata like whoa
 	// train -> route -> []stops
-	stops := addStopData(tripsByRoute)
+	stops := addStopData(feed, tripsByRoute, patterns)
 
 	writeGoFile("NYCSubwayRoutes", stops)
 	writeJSFile("nyc-subway-routes", stops)
@@ -53,10 +61,12 @@ func writeSynonyms(stops map[string]gtfs.Route) {
 }
 
 func writeStopLookup(stops map[string]gtfs.Route) {
+	stopData := getStopData()
 	// phono Name => Line => stop ID
 	stopsOut := map[string]map[string]string{}
 	for line, route := range stops {
-		for _, stop := range route.Stops {
+		for _, stopID := range routeStations(route) {
+			stop := stopData[stopID]
 			_, exists := stopsOut[stop.PhoneticName]
 			if !exists {
 				stopsOut[stop.PhoneticName] = map[string]string{}
@@ -104,7 +114,7 @@ func writeGoFile(name string, data interface{}) {
 	fmt.Fprintf(goFile, "package gtfs\n\nvar %s = %#v", strings.Title(name), data)
 }
 
-func addStopData(tripsByRoute map[string][]string) map[string]gtfs.Route {
+func addStopData(feed *static.Feed, tripsByRoute map[string][]string, patterns map[string][]static.Pattern) map[string]gtfs.Route {
 	stopData := getStopData()
 
 	out := map[string]gtfs.Route{}
@@ -125,54 +135,51 @@ func addStopData(tripsByRoute map[string][]string) map[string]gtfs.Route {
 			routeInfo.Stops = append(routeInfo.Stops, stop)
 		}
 
+		for _, pattern := range patterns[line] {
+			routeInfo.Patterns = append(routeInfo.Patterns, makePattern(feed, stopData, pattern))
+		}
+
 		out[line] = routeInfo
 	}
 
 	// add transfer datas
+	graph := feed.TransferGraph()
+	// stop ID => lines stopping there, including on branches
+	linesByStop := map[string][]string{}
+	for line, route := range out {
+		for _, stopID := range routeStations(route) {
+			linesByStop[stopID] = append(linesByStop[stopID], line)
+		}
+	}
 
 	for line, route := range out {
 		for i, stop := range route.Stops {
 			var trans []gtfs.Transfer
-			// find any other lines that also have this stop via same ID
-			// N^2 yall!!! :shruggie:
-			for _, route2 := range out {
-				if route2.Name == route.Name {
+			// every other line in the same station complex
+			sc, _ := graph.ComplexOf(stop.ID)
+			for _, stopID := range sc.Stations {
+				xfer, ok := graph.Transfer(stop.ID, stopID)
+				if !ok {
 					continue
 				}
-				for _, stop2 := range route2.Stops {
-					if stop2.ID != stop.ID {
+				for _, line2 := range linesByStop[stopID] {
+					if line2 == line {
 						continue
 					}
 					trans = append(trans, gtfs.Transfer{
-						StopID: stop.ID,
-						Route:  route2.Name,
+						StopID:             stopID,
+						Route:              line2,
+						Type:               int(xfer.Type),
+						MinTransferSeconds: int(xfer.MinTransferTime / time.Second),
 					})
 				}
 			}
-			// find any other lines that DO NOT have this stop via same ID
-			// we should already have these ref'd just need to add datas
-			for _, xfer := range stop.Transfers {
-				if xfer.StopID == stop.ID {
-					continue
-				}
-				for _, route2 := range out {
-					if route2.Name == route.Name {
-						continue
-					}
-					for _, stop2 := range route2.Stops {
-						if stop2.ID != xfer.StopID {
-							continue
-						}
-						trans = append(trans, gtfs.Transfer{
-							StopID: xfer.StopID,
-							Route:  route2.Name,
-						})
-					}
-				}
-			}
 
-			sort.Slice(trans, func(i, j int) bool {
-				return trans[i].Route < trans[j].Route
+			sort.SliceStable(trans, func(i, j int) bool {
+				if trans[i].Route != trans[j].Route {
+					return trans[i].Route < trans[j].Route
+				}
+				return trans[i].StopID < trans[j].StopID
 			})
 			out[line].Stops[i].Transfers = trans
 		}
@@ -211,165 +218,109 @@ func getStopData() map[string]gtfs.Stop {
 		stopID := record[cols["stop_id"]]
 		mtaName := record[cols["stop_name"]]
 		displayName, phonoName, syns := makeStopNames(mtaName)
+
+		lat, err := strconv.ParseFloat(record[cols["stop_lat"]], 64)
+		if err != nil {
+			fmt.Printf("invalid stop_lat for %s: %s\n", stopID, err)
+			os.Exit(1)
+		}
+		lon, err := strconv.ParseFloat(record[cols["stop_lon"]], 64)
+		if err != nil {
+			fmt.Printf("invalid stop_lon for %s: %s\n", stopID, err)
+			os.Exit(1)
+		}
+
 		stopData[stopID] = gtfs.Stop{
 			ID:           stopID,
 			MTAName:      mtaName,
 			DisplayName:  displayName,
 			PhoneticName: phonoName,
+			Lat:          lat,
+			Lon:          lon,
 			Synonyms:     syns,
 		}
 	}
 
-	// now add transfers!
-	transFile, err := os.Open("../../static_gtfs/transfers.txt")
-	if err != nil {
-		fmt.Println("unable to open transfers.txt:", err)
-		os.Exit(1)
-	}
-	defer transFile.Close()
-
-	cols = map[string]int{}
-	tr := csv.NewReader(transFile)
-transloop:
-	for {
-		record, err := tr.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			fmt.Println("unable to read transfers.txt:", err)
-			os.Exit(1)
-		}
-		if len(cols) == 0 {
-			for idx, val := range record {
-				cols[val] = idx
-			}
-			continue
-		}
-
-		from := record[cols["from_stop_id"]]
-		to := record[cols["to_stop_id"]]
-		if to == from {
-			continue transloop
-		}
-
-		for _, xfer := range stopData[from].Transfers {
-			if xfer.StopID == to {
-				continue transloop
-			}
-		}
-		fromStop := stopData[from]
-		fromStop.Transfers = append(fromStop.Transfers, gtfs.Transfer{StopID: to})
-		stopData[from] = fromStop
-	}
 	return stopData
 }
 
-func getTripStops(trips map[string]string) map[string][]string {
-	stopsFile, err := os.Open("../../static_gtfs/stop_times.txt")
-	if err != nil {
-		fmt.Println("unable to open stop_times.txt:", err)
-		os.Exit(1)
-	}
-	defer stopsFile.Close()
-
-	cols := map[string]int{}
-	// train -> trip -> stops
-	northTrips := map[string]map[string][]string{}
-	southTrips := map[string]map[string][]string{}
-	r := csv.NewReader(stopsFile)
-	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			fmt.Println("unable to read stop_times.txt:", err)
-			os.Exit(1)
-		}
-		if len(cols) == 0 {
-			for idx, val := range record {
-				cols[val] = idx
-			}
-			continue
-		}
-
-		train := trips[record[cols["trip_id"]]]
-		if _, ok := northTrips[train]; !ok {
-			northTrips[train] = map[string][]string{}
-		}
-		if _, ok := southTrips[train]; !ok {
-			southTrips[train] = map[string][]string{}
-		}
-
-		if strings.HasSuffix(record[cols["stop_id"]], "S") {
-			stopID := strings.TrimSuffix(record[cols["stop_id"]], "S")
-			southTrips[train][record[cols["trip_id"]]] = append(southTrips[train][record[cols["trip_id"]]],
-				stopID)
-		} else {
-			stopID := strings.TrimSuffix(record[cols["stop_id"]], "N")
-			northTrips[train][record[cols["trip_id"]]] = append(northTrips[train][record[cols["trip_id"]]],
-				stopID)
+func getPatterns(feed *static.Feed) map[string][]static.Pattern {
+	out := map[string][]static.Pattern{}
+	for route := range routes {
+		if pats := feed.Patterns(route); len(pats) > 0 {
+			out[route] = pats
 		}
 	}
+	return out
+}
 
-	// for each train, find the longest trip route
-	trainTrips := map[string][]string{}
-	for route, _ := range routes {
-		max := len(trainTrips[route])
-
-		for _, trips := range northTrips[route] {
-			if len(trips) > max {
-				for i := len(trips)/2 - 1; i >= 0; i-- {
-					opp := len(trips) - 1 - i
-					trips[i], trips[opp] = trips[opp], trips[i]
+// getRouteStops lists the stations of each train's longest pattern in
+// southbound order. Stops stays a single sequence along the track so
+// neighbouring stations are adjacent; branches are only described by the
+// route's Patterns.
+func getRouteStops(feed *static.Feed, patterns map[string][]static.Pattern) map[string][]string {
+	trainStops := map[string][]string{}
+	for route, pats := range patterns {
+		var longest []string
+		for _, pattern := range pats {
+			stops := feed.StationIDs(pattern.StopIDs)
+			if patternDirection(pattern) == "N" {
+				for i := len(stops)/2 - 1; i >= 0; i-- {
+					opp := len(stops) - 1 - i
+					stops[i], stops[opp] = stops[opp], stops[i]
 				}
-				trainTrips[route] = trips
-				max = len(trips)
 			}
-		}
-		for _, trips := range southTrips[route] {
-			if len(trips) > max {
-				trainTrips[route] = trips
+			if len(stops) > len(longest) {
+				longest = stops
 			}
 		}
+		trainStops[route] = longest
 	}
-	return trainTrips
+	return trainStops
 }
 
-func getRoutesByTrip() map[string]string {
-	tripsFile, err := os.Open("../../static_gtfs/trips.txt")
-	if err != nil {
-		fmt.Println("unable to open trips.txt:", err)
-		os.Exit(1)
+func makePattern(feed *static.Feed, stopData map[string]gtfs.Stop, pattern static.Pattern) gtfs.Pattern {
+	stops := feed.StationIDs(pattern.StopIDs)
+	out := gtfs.Pattern{
+		Name: stopData[stops[0]].DisplayName + " to " +
+			stopData[stops[len(stops)-1]].DisplayName,
+		Headsign:  pattern.Headsign,
+		Direction: patternDirection(pattern),
+		StopIDs:   stops,
+		Days:      pattern.Days,
+		Trips:     len(pattern.TripIDs),
 	}
-	defer tripsFile.Close()
+	for _, band := range pattern.Bands {
+		out.Bands = append(out.Bands, band.String())
+	}
+	return out
+}
 
-	cols := map[string]int{}
-	routesByTrip := map[string]string{}
-	r := csv.NewReader(tripsFile)
-	for {
-		record, err := r.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			fmt.Println("unable to read trips.txt:", err)
-			os.Exit(1)
-		}
-		if len(cols) == 0 {
-			for idx, val := range record {
-				cols[val] = idx
+// routeStations lists every station a route serves: its Stops, then any
+// stations only its patterns reach.
+func routeStations(route gtfs.Route) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, stop := range route.Stops {
+		seen[stop.ID] = true
+		out = append(out, stop.ID)
+	}
+	for _, pattern := range route.Patterns {
+		for _, stopID := range pattern.StopIDs {
+			if !seen[stopID] {
+				seen[stopID] = true
+				out = append(out, stopID)
 			}
-			continue
 		}
+	}
+	return out
+}
 
-		route := record[cols["route_id"]]
-		if _, ok := routes[route]; ok {
-			routesByTrip[record[cols["trip_id"]]] = route
-		}
+func patternDirection(pattern static.Pattern) string {
+	if strings.HasSuffix(pattern.StopIDs[0], "N") {
+		return "N"
 	}
-	return routesByTrip
+	return "S"
 }
 
 var (
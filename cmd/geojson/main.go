@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+func main() {
+	gtfsPath := flag.String("gtfs", "static_gtfs", "static GTFS zip or directory")
+	outDir := flag.String("out", ".", "directory to write the GeoJSON files to")
+	flag.Parse()
+
+	feed, err := static.Load(*gtfsPath)
+	if err != nil {
+		fmt.Println("unable to load static GTFS:", err)
+		os.Exit(1)
+	}
+	if len(feed.Shapes) == 0 {
+		fmt.Println("no shapes.txt found, routes will connect their stops")
+	}
+
+	writeFile(filepath.Join(*outDir, "subway-routes.geojson"), feed.RoutesGeoJSON())
+	writeFile(filepath.Join(*outDir, "subway-stops.geojson"), feed.StopsGeoJSON())
+}
+
+func writeFile(name string, fc static.FeatureCollection) {
+	f, err := os.Create(name)
+	if err != nil {
+		fmt.Printf("unable to create %s: %s\n", name, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	if err := static.WriteGeoJSON(f, fc); err != nil {
+		fmt.Printf("unable to write %s: %s\n", name, err)
+		os.Exit(1)
+	}
+}
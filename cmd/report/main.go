@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jprobinson/gtfs/mta"
+	"github.com/jprobinson/gtfs/report"
+	"github.com/jprobinson/gtfs/static"
+)
+
+func main() {
+	gtfsPath := flag.String("gtfs", "static_gtfs", "full static GTFS zip or directory, including stop_times.txt")
+	obsPath := flag.String("obs", "observations.csv", "observation log written by mta.Recorder")
+	from := flag.String("from", "", "start of the report period as YYYY-MM-DD, defaults to the first of last month")
+	to := flag.String("to", "", "end of the report period (exclusive) as YYYY-MM-DD, defaults to a month after -from")
+	by := flag.String("by", "route,direction", "comma separated dimensions to group by: route, direction, station, hour")
+	format := flag.String("format", "csv", "output format: csv or json")
+	flag.Parse()
+
+	dims, err := report.ParseDimension(*by)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	feed, err := static.Load(*gtfsPath)
+	if err != nil {
+		fmt.Println("unable to load static GTFS:", err)
+		os.Exit(1)
+	}
+	loc := feed.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	start := time.Now().In(loc)
+	start = time.Date(start.Year(), start.Month()-1, 1, 0, 0, 0, 0, loc)
+	if *from != "" {
+		if start, err = time.ParseInLocation("2006-01-02", *from, loc); err != nil {
+			fmt.Println("invalid -from:", err)
+			os.Exit(1)
+		}
+	}
+	end := start.AddDate(0, 1, 0)
+	if *to != "" {
+		if end, err = time.ParseInLocation("2006-01-02", *to, loc); err != nil {
+			fmt.Println("invalid -to:", err)
+			os.Exit(1)
+		}
+	}
+
+	f, err := os.Open(*obsPath)
+	if err != nil {
+		fmt.Println("unable to open observations:", err)
+		os.Exit(1)
+	}
+	obs, err := mta.ReadObservations(f, loc)
+	f.Close()
+	if err != nil {
+		fmt.Println("unable to read observations:", err)
+		os.Exit(1)
+	}
+
+	rep := report.New(feed, obs, start, end, dims)
+	switch *format {
+	case "csv":
+		err = report.WriteCSV(os.Stdout, rep)
+	case "json":
+		err = report.WriteJSON(os.Stdout, rep)
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
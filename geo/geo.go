@@ -0,0 +1,235 @@
+// Package geo answers "what stations are near me" questions offline using the
+// stop coordinates in NYCSubwayRoutes.
+package geo
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/jprobinson/gtfs"
+)
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// Station is a stop along with the lines serving it.
+type Station struct {
+	gtfs.Stop
+	// Lines are the routes serving the stop, sorted.
+	Lines []string
+}
+
+// Result is a station found near a point.
+type Result struct {
+	Station
+	// Distance is the great circle distance to the station in meters.
+	Distance float64
+}
+
+// Index is a k-d tree of stations. Stations are placed on the unit sphere so
+// straight line (chord) distances order the same as distances along the
+// earth's surface.
+type Index struct {
+	root *node
+}
+
+type node struct {
+	station Station
+	point   [3]float64
+	axis    int
+
+	left, right *node
+}
+
+// NewIndex will index every stop with coordinates in the given routes.
+func NewIndex(routes map[string]gtfs.Route) *Index {
+	stations := map[string]*Station{}
+	for line, route := range routes {
+		for _, stop := range route.Stops {
+			if stop.Lat == 0 && stop.Lon == 0 {
+				continue
+			}
+			st, ok := stations[stop.ID]
+			if !ok {
+				st = &Station{Stop: stop}
+				stations[stop.ID] = st
+			}
+			st.Lines = append(st.Lines, line)
+		}
+	}
+
+	nodes := make([]*node, 0, len(stations))
+	for _, st := range stations {
+		sort.Strings(st.Lines)
+		nodes = append(nodes, &node{station: *st, point: toPoint(st.Lat, st.Lon)})
+	}
+	// build from a stable order so equal distances always break the same way
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].station.ID < nodes[j].station.ID
+	})
+	return &Index{root: build(nodes, 0)}
+}
+
+func build(nodes []*node, depth int) *node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	axis := depth % 3
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].point[axis] < nodes[j].point[axis]
+	})
+	mid := len(nodes) / 2
+	n := nodes[mid]
+	n.axis = axis
+	n.left = build(nodes[:mid], depth+1)
+	n.right = build(nodes[mid+1:], depth+1)
+	return n
+}
+
+var (
+	defaultOnce  sync.Once
+	defaultIndex *Index
+)
+
+func defaultIdx() *Index {
+	defaultOnce.Do(func() {
+		defaultIndex = NewIndex(gtfs.NYCSubwayRoutes)
+	})
+	return defaultIndex
+}
+
+// NearestStations will return the k stations in NYCSubwayRoutes closest to
+// the given point, nearest first.
+func NearestStations(lat, lon float64, k int) []Result {
+	return defaultIdx().NearestStations(lat, lon, k)
+}
+
+// WithinRadius will return the stations in NYCSubwayRoutes within the given
+// number of meters of a point, nearest first.
+func WithinRadius(lat, lon, meters float64) []Result {
+	return defaultIdx().WithinRadius(lat, lon, meters)
+}
+
+// NearestStations will return the k stations closest to the given point,
+// nearest first.
+func (ix *Index) NearestStations(lat, lon float64, k int) []Result {
+	if k <= 0 {
+		return nil
+	}
+	target := toPoint(lat, lon)
+
+	// best holds the k closest nodes found so far, sorted by distance
+	var best []candidate
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		d := sqDist(n.point, target)
+		if len(best) < k || d < best[len(best)-1].sqDist {
+			best = insertCandidate(best, candidate{n: n, sqDist: d}, k)
+		}
+
+		diff := target[n.axis] - n.point[n.axis]
+		near, far := n.left, n.right
+		if diff > 0 {
+			near, far = n.right, n.left
+		}
+		search(near)
+		if len(best) < k || diff*diff < best[len(best)-1].sqDist {
+			search(far)
+		}
+	}
+	search(ix.root)
+	return results(best, lat, lon)
+}
+
+// WithinRadius will return the stations within the given number of meters of
+// a point, nearest first.
+func (ix *Index) WithinRadius(lat, lon, meters float64) []Result {
+	target := toPoint(lat, lon)
+	chord := 2 * math.Sin(math.Min(meters/EarthRadius, math.Pi)/2)
+	limit := chord * chord
+
+	var found []candidate
+	var search func(n *node)
+	search = func(n *node) {
+		if n == nil {
+			return
+		}
+		if d := sqDist(n.point, target); d <= limit {
+			found = append(found, candidate{n: n, sqDist: d})
+		}
+		diff := target[n.axis] - n.point[n.axis]
+		if diff <= 0 || diff*diff <= limit {
+			search(n.left)
+		}
+		if diff >= 0 || diff*diff <= limit {
+			search(n.right)
+		}
+	}
+	search(ix.root)
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].sqDist < found[j].sqDist
+	})
+	return results(found, lat, lon)
+}
+
+type candidate struct {
+	n      *node
+	sqDist float64
+}
+
+func insertCandidate(best []candidate, c candidate, k int) []candidate {
+	idx := sort.Search(len(best), func(i int) bool {
+		return best[i].sqDist > c.sqDist
+	})
+	best = append(best, candidate{})
+	copy(best[idx+1:], best[idx:])
+	best[idx] = c
+	if len(best) > k {
+		best = best[:k]
+	}
+	return best
+}
+
+func results(cands []candidate, lat, lon float64) []Result {
+	out := make([]Result, len(cands))
+	for i, c := range cands {
+		out[i] = Result{
+			Station:  c.n.station,
+			Distance: Distance(lat, lon, c.n.station.Lat, c.n.station.Lon),
+		}
+	}
+	return out
+}
+
+// Distance returns the great circle distance in meters between two points.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	p1, p2 := radians(lat1), radians(lat2)
+	dp := radians(lat2 - lat1)
+	dl := radians(lon2 - lon1)
+	a := math.Sin(dp/2)*math.Sin(dp/2) +
+		math.Cos(p1)*math.Cos(p2)*math.Sin(dl/2)*math.Sin(dl/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func toPoint(lat, lon float64) [3]float64 {
+	p, l := radians(lat), radians(lon)
+	return [3]float64{
+		math.Cos(p) * math.Cos(l),
+		math.Cos(p) * math.Sin(l),
+		math.Sin(p),
+	}
+}
+
+func sqDist(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,116 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/jprobinson/gtfs"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		want                   float64
+	}{
+		{"same point", 40.7559, -73.9871, 40.7559, -73.9871, 0},
+		{"one degree of latitude", 40, -74, 41, -74, 111195},
+		{"quarter of the equator", 0, 0, 0, 90, math.Pi / 2 * EarthRadius},
+		{"antipodes", 0, 0, 0, 180, math.Pi * EarthRadius},
+		// Times Sq - 42 St to Grand Central - 42 St
+		{"shuttle", 40.755983, -73.986229, 40.752769, -73.979189, 692.4},
+	}
+	for _, tt := range tests {
+		got := Distance(tt.lat1, tt.lon1, tt.lat2, tt.lon2)
+		if math.Abs(got-tt.want) > 1 {
+			t.Errorf("%s: Distance = %.1f, want %.1f", tt.name, got, tt.want)
+		}
+	}
+}
+
+// bruteForce returns the distance to every station, nearest first.
+func bruteForce(lat, lon float64) []Result {
+	seen := map[string]bool{}
+	var out []Result
+	for _, route := range gtfs.NYCSubwayRoutes {
+		for _, stop := range route.Stops {
+			if seen[stop.ID] || (stop.Lat == 0 && stop.Lon == 0) {
+				continue
+			}
+			seen[stop.ID] = true
+			out = append(out, Result{
+				Station:  Station{Stop: stop},
+				Distance: Distance(lat, lon, stop.Lat, stop.Lon),
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Distance < out[j].Distance
+	})
+	return out
+}
+
+func testPoints() [][2]float64 {
+	points := [][2]float64{
+		{40.755983, -73.986229}, // on top of a station
+		{40.7128, -74.0060},
+		{40.6782, -73.9442},
+		{40.5, -74.3}, // outside the city
+		{41.5, -73},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		points = append(points, [2]float64{40.55 + rnd.Float64()*0.35, -74.05 + rnd.Float64()*0.35})
+	}
+	return points
+}
+
+// sameResults compares results by distance, since stations the same
+// distance away may come in either order.
+func sameResults(t *testing.T, got, want []Result, name string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d results, want %d", name, len(got), len(want))
+	}
+	for i := range got {
+		if math.Abs(got[i].Distance-want[i].Distance) > 1e-6 {
+			t.Fatalf("%s: result %d is %s at %.2fm, want %s at %.2fm",
+				name, i, got[i].ID, got[i].Distance, want[i].ID, want[i].Distance)
+		}
+	}
+}
+
+func TestNearestStations(t *testing.T) {
+	for _, p := range testPoints() {
+		all := bruteForce(p[0], p[1])
+		for _, k := range []int{1, 3, 10, 50} {
+			got := NearestStations(p[0], p[1], k)
+			sameResults(t, got, all[:k], "NearestStations")
+			for _, r := range got {
+				if len(r.Lines) == 0 {
+					t.Fatalf("station %s has no lines", r.ID)
+				}
+			}
+		}
+	}
+	if got := NearestStations(40.7, -74, 0); got != nil {
+		t.Errorf("NearestStations with k=0 = %v, want nil", got)
+	}
+}
+
+func TestWithinRadius(t *testing.T) {
+	for _, p := range testPoints() {
+		all := bruteForce(p[0], p[1])
+		for _, meters := range []float64{0, 250, 1000, 5000} {
+			var want []Result
+			for _, r := range all {
+				if r.Distance <= meters {
+					want = append(want, r)
+				}
+			}
+			sameResults(t, WithinRadius(p[0], p[1], meters), want, "WithinRadius")
+		}
+	}
+}
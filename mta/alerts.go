@@ -0,0 +1,272 @@
+package mta
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// AlertLanguage is the language alert text is translated to when a feed
+// offers more than one.
+var AlertLanguage = "en"
+
+// SubwayAgencyIDs are the agency IDs alerts use for the subway. Entities for
+// any other agency, like the LIRR or Metro-North, are ignored.
+var SubwayAgencyIDs = []string{"MTA NYCT", "MTASBWY"}
+
+// Alert is a service alert with its informed entities resolved to the
+// subway's routes and stops.
+type Alert struct {
+	// ID is the ID of the feed entity carrying the alert.
+	ID string
+
+	Header      string
+	Description string
+	URL         string
+
+	Cause  transit_realtime.Alert_Cause
+	Effect transit_realtime.Alert_Effect
+
+	// ActivePeriods are when the alert is in effect. No periods means it
+	// always is.
+	ActivePeriods []ActivePeriod
+
+	// Routes and Stops are the subway routes and stops the alert names
+	// directly. Entities outside NYCSubwayRoutes are left out but still
+	// count when matching in AlertsFor.
+	Routes []gtfs.Route
+	Stops  []gtfs.Stop
+	// TripIDs are the realtime IDs of trips the alert names.
+	TripIDs []string
+
+	Raw *transit_realtime.Alert
+}
+
+// ActivePeriod is a span of time an alert is in effect. A zero Start or End
+// leaves that side of the span open.
+type ActivePeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ActiveAt reports whether the alert is in effect at t.
+func (a Alert) ActiveAt(t time.Time) bool {
+	if len(a.ActivePeriods) == 0 {
+		return true
+	}
+	for _, p := range a.ActivePeriods {
+		if (p.Start.IsZero() || !t.Before(p.Start)) && (p.End.IsZero() || t.Before(p.End)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Alerts will extract every alert in a feed, translated to AlertLanguage.
+func Alerts(f *transit_realtime.FeedMessage) []Alert {
+	var out []Alert
+	for _, ent := range f.GetEntity() {
+		if ent.GetAlert() == nil {
+			continue
+		}
+		out = append(out, NewAlert(ent.GetId(), ent.GetAlert()))
+	}
+	return out
+}
+
+// NewAlert will resolve a realtime alert.
+func NewAlert(id string, a *transit_realtime.Alert) Alert {
+	alert := Alert{
+		ID:          id,
+		Header:      Translation(a.GetHeaderText(), AlertLanguage),
+		Description: Translation(a.GetDescriptionText(), AlertLanguage),
+		URL:         Translation(a.GetUrl(), AlertLanguage),
+		Cause:       a.GetCause(),
+		Effect:      a.GetEffect(),
+		Raw:         a,
+	}
+	for _, tr := range a.GetActivePeriod() {
+		var p ActivePeriod
+		if tr.GetStart() != 0 {
+			p.Start = time.Unix(int64(tr.GetStart()), 0)
+		}
+		if tr.GetEnd() != 0 {
+			p.End = time.Unix(int64(tr.GetEnd()), 0)
+		}
+		alert.ActivePeriods = append(alert.ActivePeriods, p)
+	}
+
+	seenRoutes, seenStops := map[string]bool{}, map[string]bool{}
+	for _, sel := range a.GetInformedEntity() {
+		if !subwayAgency(sel.GetAgencyId()) {
+			continue
+		}
+		if routeID := selectorRoute(sel); routeID != "" && !seenRoutes[routeID] {
+			seenRoutes[routeID] = true
+			if route, ok := subwayRoute(routeID); ok {
+				alert.Routes = append(alert.Routes, route)
+			}
+		}
+		if tripID := sel.GetTrip().GetTripId(); tripID != "" {
+			alert.TripIDs = append(alert.TripIDs, tripID)
+		}
+		if stopID := stationID(sel.GetStopId()); stopID != "" && !seenStops[stopID] {
+			seenStops[stopID] = true
+			if stop, ok := stopsByID()[stopID]; ok {
+				alert.Stops = append(alert.Stops, stop)
+			}
+		}
+	}
+	return alert
+}
+
+// Translation will pick the text for a language out of a translated string.
+// It falls back to text without a language, then text in the same base
+// language (skipping NYCT's "en-html" markup), then whatever comes first.
+func Translation(ts *transit_realtime.TranslatedString, lang string) string {
+	trs := ts.GetTranslation()
+	if len(trs) == 0 {
+		return ""
+	}
+	for _, tr := range trs {
+		if strings.EqualFold(tr.GetLanguage(), lang) {
+			return tr.GetText()
+		}
+	}
+	for _, tr := range trs {
+		if tr.GetLanguage() == "" {
+			return tr.GetText()
+		}
+	}
+	base := strings.SplitN(lang, "-", 2)[0]
+	for _, tr := range trs {
+		l := strings.ToLower(tr.GetLanguage())
+		if strings.SplitN(l, "-", 2)[0] == strings.ToLower(base) && !strings.HasSuffix(l, "-html") {
+			return tr.GetText()
+		}
+	}
+	return trs[0].GetText()
+}
+
+// AlertsFor will return the alerts in effect at now that affect a route,
+// a stop or both. Either may be empty to match any. Alerts naming a route
+// affect the stops it serves and alerts naming a stop affect the routes
+// serving it. Alerts for the whole subway affect everything.
+func AlertsFor(alerts []Alert, routeID, stopID string, now time.Time) []Alert {
+	var out []Alert
+	for _, a := range alerts {
+		if !a.ActiveAt(now) {
+			continue
+		}
+		for _, sel := range a.Raw.GetInformedEntity() {
+			if selectorMatches(sel, routeID, stopID) {
+				out = append(out, a)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// AlertsFor behaves like the package level AlertsFor across every alert in
+// the snapshot.
+func (s *Snapshot) AlertsFor(routeID, stopID string, now time.Time) []Alert {
+	var alerts []Alert
+	for _, ft := range sortedFeedTypes(s.Feeds) {
+		alerts = append(alerts, Alerts(s.Feeds[ft])...)
+	}
+	return AlertsFor(alerts, routeID, stopID, now)
+}
+
+func selectorMatches(sel *transit_realtime.EntitySelector, routeID, stopID string) bool {
+	if !subwayAgency(sel.GetAgencyId()) {
+		return false
+	}
+	selRoute, selStop := selectorRoute(sel), stationID(sel.GetStopId())
+	if selRoute == "" && selStop == "" {
+		// a trip without its route can not be placed
+		return sel.GetTrip() == nil
+	}
+
+	if routeID != "" {
+		if selRoute != "" && !routeMatches(routeID, selRoute) && !routeMatches(selRoute, routeID) {
+			return false
+		}
+		if selRoute == "" && !serves(routeID, selStop) {
+			return false
+		}
+	}
+	if stopID != "" {
+		if selStop != "" && selStop != stationID(stopID) {
+			return false
+		}
+		if selStop == "" && !serves(selRoute, stationID(stopID)) {
+			return false
+		}
+	}
+	return true
+}
+
+// subwayAgency reports whether an entity's agency is the subway. Entities
+// without one are assumed to be.
+func subwayAgency(agencyID string) bool {
+	if agencyID == "" {
+		return true
+	}
+	for _, id := range SubwayAgencyIDs {
+		if id == agencyID {
+			return true
+		}
+	}
+	return false
+}
+
+func selectorRoute(sel *transit_realtime.EntitySelector) string {
+	if id := sel.GetRouteId(); id != "" {
+		return id
+	}
+	return sel.GetTrip().GetRouteId()
+}
+
+func subwayRoute(routeID string) (gtfs.Route, bool) {
+	if route, ok := gtfs.NYCSubwayRoutes[routeID]; ok {
+		return route, true
+	}
+	route, ok := gtfs.NYCSubwayRoutes[strings.TrimSuffix(routeID, "X")]
+	return route, ok
+}
+
+// serves reports whether a route stops at a station, including stations
+// only its branches reach.
+func serves(routeID, stationID string) bool {
+	route, ok := subwayRoute(routeID)
+	if !ok {
+		return false
+	}
+	for _, stop := range route.Stops {
+		if stop.ID == stationID {
+			return true
+		}
+	}
+	for _, pattern := range route.Patterns {
+		for _, stopID := range pattern.StopIDs {
+			if stopID == stationID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stationID trims the direction off an NYCT platform ID.
+func stationID(stopID string) string {
+	if _, ok := stopsByID()[stopID]; ok {
+		return stopID
+	}
+	if n := len(stopID); n > 1 && (stopID[n-1] == 'N' || stopID[n-1] == 'S') {
+		return stopID[:n-1]
+	}
+	return stopID
+}
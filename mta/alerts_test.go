@@ -0,0 +1,59 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testAlertEntity(id string, sels ...*transit_realtime.EntitySelector) *transit_realtime.FeedEntity {
+	return &transit_realtime.FeedEntity{
+		Id:    proto.String(id),
+		Alert: &transit_realtime.Alert{InformedEntity: sels},
+	}
+}
+
+func TestSnapshotAlertsFor(t *testing.T) {
+	feed := testFeedMessage(time.Now())
+	feed.Entity = []*transit_realtime.FeedEntity{
+		testAlertEntity("lirr", &transit_realtime.EntitySelector{AgencyId: proto.String("LIRR")}),
+		testAlertEntity("lirr-route", &transit_realtime.EntitySelector{
+			AgencyId: proto.String("LIRR"), RouteId: proto.String("1")}),
+		testAlertEntity("subway", &transit_realtime.EntitySelector{AgencyId: proto.String("MTASBWY")}),
+		testAlertEntity("route", &transit_realtime.EntitySelector{
+			AgencyId: proto.String("MTASBWY"), RouteId: proto.String("1")}),
+		testAlertEntity("stop", &transit_realtime.EntitySelector{StopId: proto.String("127N")}),
+	}
+	snap := NewSnapshot(map[FeedType]*transit_realtime.FeedMessage{NumberedFeed: feed})
+
+	tests := []struct {
+		name    string
+		routeID string
+		stopID  string
+		want    []string
+	}{
+		{"everything", "", "", []string{"subway", "route", "stop"}},
+		{"route", "1", "", []string{"subway", "route", "stop"}},
+		{"express", "1X", "", []string{"subway", "route", "stop"}},
+		{"other route", "A", "", []string{"subway"}},
+		{"stop", "", "127", []string{"subway", "route", "stop"}},
+		{"platform", "", "127S", []string{"subway", "route", "stop"}},
+		{"other stop", "", "A27", []string{"subway"}},
+		{"route and stop", "1", "127", []string{"subway", "route", "stop"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, a := range snap.AlertsFor(tt.routeID, tt.stopID, time.Now()) {
+				got = append(got, a.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AlertsFor(%q, %q) = %v, want %v", tt.routeID, tt.stopID, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package mta
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs"
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// ErrUnknownStation is returned when a board is requested for a station name
+// or stop ID that is not in NYCSubwayRoutes.
+var ErrUnknownStation = errors.New("unknown station")
+
+// BoardHorizon is how far ahead scheduled departures are added to a board
+// for lines without realtime data.
+var BoardHorizon = time.Hour
+
+// Board lists the upcoming trains for every line at a station complex.
+type Board struct {
+	Name string
+
+	Northbound []BoardEntry
+	Southbound []BoardEntry
+}
+
+// BoardEntry is a single upcoming train on a Board.
+type BoardEntry struct {
+	Route  string
+	StopID string
+	TripID string
+
+	// DirectionLabel is the route's Northbound or Southbound value, like
+	// "Bronx" or "Brooklyn".
+	DirectionLabel string
+	Headsign       string
+
+	Time        time.Time
+	MinutesAway int
+
+	// Realtime is false for entries taken from the static schedule.
+	Realtime bool
+	// Assigned is true when the trip has been assigned to a physical train.
+	Assigned bool
+	// Rerouted is true when the train is running off its scheduled track and
+	// its prediction may be unreliable.
+	Rerouted bool
+}
+
+// NewBoard will build an arrival board for a station given either a name
+// from NYCSubwayStopsByName ("Times Square, 42nd Street") or a parent stop
+// ID ("127"). Every line at the station complex is included using the
+// stop's transfers. The matcher is optional: when given, it is used for trip
+// headsigns and to fill in scheduled departures wherever a line has no
+// realtime trains in a direction.
+func NewBoard(snap *Snapshot, m *TripMatcher, station string, now time.Time) (Board, error) {
+	board := Board{}
+	stops := stationStops(station)
+	if len(stops) == 0 {
+		return board, ErrUnknownStation
+	}
+	board.Name = stopsByID()[stops[0].StopID].DisplayName
+
+	for _, rs := range stops {
+		found := map[string]bool{}
+		for _, dir := range []string{"N", "S"} {
+			for _, su := range snap.Stops[rs.StopID+dir] {
+				if !routeMatches(rs.Route, su.Trip.GetTrip().GetRouteId()) {
+					continue
+				}
+				p, ok := predict(su.Update, false)
+				if !ok || !p.Time.After(now) {
+					continue
+				}
+				found[dir] = true
+				board.add(dir, BoardEntry{
+					Route:          rs.Route,
+					StopID:         su.Update.GetStopId(),
+					TripID:         su.Trip.GetTrip().GetTripId(),
+					DirectionLabel: directionLabel(rs.Route, dir),
+					Headsign:       realtimeHeadsign(m, su.Trip, now),
+					Time:           p.Time,
+					MinutesAway:    int(p.Time.Sub(now) / time.Minute),
+					Realtime:       true,
+					Assigned:       NyctTripDescriptor(su.Trip.GetTrip()).GetIsAssigned(),
+					Rerouted:       TrackChanged(su.Update),
+				})
+			}
+		}
+		if m == nil || (found["N"] && found["S"]) {
+			continue
+		}
+
+		for _, dept := range m.feed.ScheduledDepartures(rs.StopID, rs.Route, static.AnyDirection, now, now.Add(BoardHorizon)) {
+			dir := "N"
+			if strings.HasSuffix(dept.StopTime.StopID, "S") {
+				dir = "S"
+			}
+			if found[dir] {
+				continue
+			}
+			board.add(dir, BoardEntry{
+				Route:          rs.Route,
+				StopID:         dept.StopTime.StopID,
+				TripID:         dept.Trip.ID,
+				DirectionLabel: directionLabel(rs.Route, dir),
+				Headsign:       dept.Trip.Headsign,
+				Time:           dept.Time,
+				MinutesAway:    int(dept.Time.Sub(now) / time.Minute),
+			})
+		}
+	}
+
+	for _, entries := range [][]BoardEntry{board.Northbound, board.Southbound} {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].Time.Before(entries[j].Time)
+		})
+	}
+	return board, nil
+}
+
+func (b *Board) add(dir string, e BoardEntry) {
+	if dir == "N" {
+		b.Northbound = append(b.Northbound, e)
+	} else {
+		b.Southbound = append(b.Southbound, e)
+	}
+}
+
+// stationStops resolves a station name or stop ID to the routes stopping at
+// it and at any of its transfers.
+func stationStops(station string) []gtfs.Transfer {
+	var (
+		out []gtfs.Transfer
+		// a route can be listed as a transfer at its own stop, so only the
+		// stop and route make an entry unique
+		seen = map[gtfs.Transfer]bool{}
+	)
+	add := func(t gtfs.Transfer) {
+		key := gtfs.Transfer{StopID: t.StopID, Route: t.Route}
+		if !seen[key] {
+			seen[key] = true
+			out = append(out, t)
+		}
+	}
+
+	lines, ok := gtfs.NYCSubwayStopsByName[station]
+	if !ok {
+		lines = map[string]string{}
+		for name, route := range gtfs.NYCSubwayRoutes {
+			for _, stop := range route.Stops {
+				if stop.ID == station {
+					lines[name] = stop.ID
+				}
+			}
+		}
+	}
+
+	routes := make([]string, 0, len(lines))
+	for route := range lines {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	for _, route := range routes {
+		add(gtfs.Transfer{StopID: lines[route], Route: route})
+		for _, xfer := range stopsByID()[lines[route]].Transfers {
+			add(xfer)
+		}
+	}
+	return out
+}
+
+var (
+	stopIndexOnce sync.Once
+	stopIndex     map[string]gtfs.Stop
+)
+
+func stopsByID() map[string]gtfs.Stop {
+	stopIndexOnce.Do(func() {
+		stopIndex = map[string]gtfs.Stop{}
+		for _, route := range gtfs.NYCSubwayRoutes {
+			for _, stop := range route.Stops {
+				stopIndex[stop.ID] = stop
+			}
+		}
+	})
+	return stopIndex
+}
+
+// routeMatches reports whether a realtime route ID runs as the given line,
+// treating express variants like 6X as their line.
+func routeMatches(line, routeID string) bool {
+	return line == routeID || line == strings.TrimSuffix(routeID, "X")
+}
+
+func directionLabel(route, dir string) string {
+	r, ok := gtfs.NYCSubwayRoutes[route]
+	if !ok {
+		r = gtfs.NYCSubwayRoutes[strings.TrimSuffix(route, "X")]
+	}
+	if dir == "N" {
+		return r.Northbound
+	}
+	return r.Southbound
+}
+
+// realtimeHeadsign uses the static trip's headsign when it can be matched and
+// falls back to the name of the trip's last predicted stop.
+func realtimeHeadsign(m *TripMatcher, tu *transit_realtime.TripUpdate, now time.Time) string {
+	if m != nil {
+		if trip, _, err := m.Match(tu.GetTrip(), now); err == nil && trip.Headsign != "" {
+			return trip.Headsign
+		}
+	}
+	upds := tu.GetStopTimeUpdate()
+	if len(upds) == 0 {
+		return ""
+	}
+	last := upds[len(upds)-1].GetStopId()
+	return stopsByID()[strings.TrimRight(last, "NS")].DisplayName
+}
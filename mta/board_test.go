@@ -0,0 +1,181 @@
+package mta
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// testBoardTrip is a realtime trip update stopping at each stop at the
+// given time.
+func testBoardTrip(t *testing.T, tripID, routeID string, assigned bool, stops ...interface{}) *transit_realtime.FeedEntity {
+	t.Helper()
+	td := &transit_realtime.TripDescriptor{
+		TripId:    proto.String(tripID),
+		RouteId:   proto.String(routeID),
+		StartDate: proto.String("20200218"),
+	}
+	if assigned {
+		err := proto.SetExtension(td, transit_realtime.E_NyctTripDescriptor, &transit_realtime.NyctTripDescriptor{
+			IsAssigned: proto.Bool(true),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tu := &transit_realtime.TripUpdate{Trip: td}
+	for i := 0; i+1 < len(stops); i += 2 {
+		at := stops[i+1].(time.Time)
+		tu.StopTimeUpdate = append(tu.StopTimeUpdate, testStopTimeUpdate(stops[i].(string), at, at, 0))
+	}
+	return &transit_realtime.FeedEntity{Id: proto.String(tripID), TripUpdate: tu}
+}
+
+func testBoardSnapshot(ents ...*transit_realtime.FeedEntity) *Snapshot {
+	feed := testFeedMessage(time.Now())
+	feed.Entity = ents
+	return NewSnapshot(map[FeedType]*transit_realtime.FeedMessage{NumberedFeed: feed})
+}
+
+func boardEntries(entries []BoardEntry) []string {
+	var out []string
+	for _, e := range entries {
+		out = append(out, fmt.Sprintf("%s %s %s to %s (%s) in %d realtime:%t assigned:%t",
+			e.Route, e.StopID, e.TripID, e.Headsign, e.DirectionLabel, e.MinutesAway, e.Realtime, e.Assigned))
+	}
+	return out
+}
+
+func TestNewBoard(t *testing.T) {
+	m := testMatcher(t)
+	now := time.Date(2020, 2, 18, 8, 2, 0, 0, m.location())
+	min := func(m int) time.Time {
+		return now.Add(time.Duration(m) * time.Minute)
+	}
+
+	northbound := testBoardTrip(t, "060000_1..N03R", "1", false,
+		"104N", min(5), "103N", min(7), "101N", min(12))
+	realtime := testBoardSnapshot(
+		testBoardTrip(t, "049000_1..S03R", "1", false,
+			"101S", min(9), "103S", min(14), "104S", min(19)),
+		testBoardTrip(t, "048000_1..S03R", "1", true,
+			"103S", min(4), "104S", min(9)),
+		// already gone
+		testBoardTrip(t, "047000_1..S03R", "1", true,
+			"103S", min(-1), "104S", min(4)),
+		// another line
+		testBoardTrip(t, "049200_2..S01R", "2", false,
+			"103S", min(2)),
+		northbound,
+	)
+	northOnly := testBoardSnapshot(northbound)
+
+	defer func(horizon time.Duration) { BoardHorizon = horizon }(BoardHorizon)
+	BoardHorizon = 15 * time.Minute
+
+	tests := []struct {
+		name    string
+		snap    *Snapshot
+		matcher *TripMatcher
+		station string
+
+		wantName  string
+		wantNorth []string
+		wantSouth []string
+		wantErr   error
+	}{
+		{
+			name:     "realtime",
+			snap:     realtime,
+			matcher:  m,
+			station:  "103",
+			wantName: "238th Street",
+			wantNorth: []string{
+				"1 103N 060000_1..N03R to Van Cortlandt Park - 242nd Street (Bronx) in 7 realtime:true assigned:false",
+			},
+			wantSouth: []string{
+				"1 103S 048000_1..S03R to South Ferry (South Ferry) in 4 realtime:true assigned:true",
+				"1 103S 049000_1..S03R to South Ferry (South Ferry) in 14 realtime:true assigned:false",
+			},
+		},
+		{
+			// the 08:15 short run ends at 238 St and the 08:25 is past the
+			// horizon
+			name:     "scheduled",
+			snap:     northOnly,
+			matcher:  m,
+			station:  "103",
+			wantName: "238th Street",
+			wantNorth: []string{
+				"1 103N 060000_1..N03R to Van Cortlandt Park - 242nd Street (Bronx) in 7 realtime:true assigned:false",
+			},
+			wantSouth: []string{
+				"1 103S AFA19GEN-1037-Weekday-00_048000_1..S03R to South Ferry (South Ferry) in 3 realtime:false assigned:false",
+				"1 103S AFA19GEN-1037-Weekday-00_049000_1..S03R to South Ferry (South Ferry) in 13 realtime:false assigned:false",
+			},
+		},
+		{
+			name:     "no matcher",
+			snap:     northOnly,
+			station:  "103",
+			wantName: "238th Street",
+			wantNorth: []string{
+				"1 103N 060000_1..N03R to Van Cortlandt Park - 242nd Street (Bronx) in 7 realtime:true assigned:false",
+			},
+		},
+		{
+			name: "complex",
+			snap: testBoardSnapshot(
+				testBoardTrip(t, "048000_1..S03R", "1", true, "127S", min(4), "142S", min(20)),
+				testBoardTrip(t, "048100_1..S03R", "1", false, "127S", min(-1), "142S", min(15)),
+				testBoardTrip(t, "048200_2..N01R", "2", false, "127N", min(1), "201N", min(50)),
+				testBoardTrip(t, "048300_7..N", "7", false, "725N", min(2), "701N", min(30)),
+				testBoardTrip(t, "048400_7X..N", "7X", false, "725N", min(6), "701N", min(28)),
+				// 42 St - Grand Central is not part of the complex
+				testBoardTrip(t, "048500_6..S", "6", false, "631S", min(3)),
+			),
+			station:  "Times Square",
+			wantName: "Times Square - 42nd Street",
+			wantNorth: []string{
+				"2 127N 048200_2..N01R to Wakefield - 241st Street (Bronx) in 1 realtime:true assigned:false",
+				"7 725N 048300_7..N to Flushing - Main Street (Queens) in 2 realtime:true assigned:false",
+				"7 725N 048400_7X..N to Flushing - Main Street (Queens) in 6 realtime:true assigned:false",
+			},
+			wantSouth: []string{
+				"1 127S 048000_1..S03R to South Ferry (South Ferry) in 4 realtime:true assigned:true",
+			},
+		},
+		{
+			name:    "unknown",
+			snap:    realtime,
+			station: "Nowhere",
+			wantErr: ErrUnknownStation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, err := NewBoard(tt.snap, tt.matcher, tt.station, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewBoard error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if board.Name != tt.wantName {
+				t.Errorf("name = %q, want %q", board.Name, tt.wantName)
+			}
+			if got := boardEntries(board.Northbound); !reflect.DeepEqual(got, tt.wantNorth) {
+				t.Errorf("northbound = %q, want %q", got, tt.wantNorth)
+			}
+			if got := boardEntries(board.Southbound); !reflect.DeepEqual(got, tt.wantSouth) {
+				t.Errorf("southbound = %q, want %q", got, tt.wantSouth)
+			}
+		})
+	}
+}
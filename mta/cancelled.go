@@ -0,0 +1,145 @@
+package mta
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// ReplacementPeriod is a window in which a realtime feed replaces the static
+// schedule for a route, along with the scheduled trips that went missing.
+type ReplacementPeriod struct {
+	RouteID string
+
+	Start time.Time
+	End   time.Time
+
+	Cancelled []CancelledTrip
+}
+
+// CancelledTrip is a scheduled trip that was dropped from the realtime feed.
+type CancelledTrip struct {
+	Trip        static.Trip
+	ServiceDate time.Time
+	// Departure is the scheduled departure from the trip's origin.
+	Departure time.Time
+}
+
+// CancelledTrips will find the scheduled trips cancelled by a feed. The NYCT
+// feed header lists a trip replacement period per route: the feed contains
+// every trip starting in that period, so any scheduled trip starting within
+// it that is missing from the feed (or is explicitly marked as canceled)
+// should be considered cancelled. A realtime trip only accounts for a
+// scheduled one when its trip ID matches exactly or its start time is the
+// scheduled departure. Periods are returned ordered by route and nil is
+// returned if the feed carries no NYCT header. Feeds without a timestamp are
+// taken to be as of now.
+func CancelledTrips(f *transit_realtime.FeedMessage, m *TripMatcher, now time.Time) []ReplacementPeriod {
+	nfh := NyctFeedHeader(f.GetHeader())
+	if nfh == nil {
+		return nil
+	}
+	now = now.In(m.location())
+	if ts := f.GetHeader().GetTimestamp(); ts != 0 {
+		now = time.Unix(int64(ts), 0).In(m.location())
+	}
+
+	// static trip ID => service date key of trips present in the feed
+	running := map[string]map[string]bool{}
+	for _, ent := range f.GetEntity() {
+		td := ent.GetTripUpdate().GetTrip()
+		if td == nil {
+			td = ent.GetVehicle().GetTrip()
+		}
+		if td == nil || td.GetScheduleRelationship() == transit_realtime.TripDescriptor_CANCELED {
+			continue
+		}
+		trip, date, err := m.Match(td, now)
+		if err != nil || !runs(m.feed, td, trip) {
+			continue
+		}
+		if running[trip.ID] == nil {
+			running[trip.ID] = map[string]bool{}
+		}
+		running[trip.ID][date.Format(static.DateFormat)] = true
+	}
+
+	var out []ReplacementPeriod
+	for _, trp := range nfh.GetTripReplacementPeriod() {
+		period := ReplacementPeriod{
+			RouteID: trp.GetRouteId(),
+			// the start is omitted by NYCT, meaning now
+			Start: now,
+			End:   time.Unix(int64(trp.GetReplacementPeriod().GetEnd()), 0).In(m.location()),
+		}
+		if start := trp.GetReplacementPeriod().GetStart(); start != 0 {
+			period.Start = time.Unix(int64(start), 0).In(m.location())
+		}
+
+		for _, sched := range scheduledStarts(m.feed, period.RouteID, period.Start, period.End) {
+			if running[sched.Trip.ID][sched.ServiceDate.Format(static.DateFormat)] {
+				continue
+			}
+			period.Cancelled = append(period.Cancelled, sched)
+		}
+		out = append(out, period)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].RouteID < out[j].RouteID
+	})
+	return out
+}
+
+// runs reports whether a realtime trip is certainly the static trip it was
+// matched to. Match falls back to a trip on another path when the path codes
+// differ, which cannot tell which of several such trips is still running.
+func runs(feed *static.Feed, td *transit_realtime.TripDescriptor, trip static.Trip) bool {
+	if strings.HasSuffix(trip.ID, "_"+td.GetTripId()) {
+		return true
+	}
+	start, err := static.ParseTime(td.GetStartTime())
+	if err != nil || start == static.NoTime {
+		return false
+	}
+	sts := feed.StopTimes[trip.ID]
+	if len(sts) == 0 {
+		return false
+	}
+	// trips after midnight are scheduled past 24:00 on the previous day
+	dept := sts[0].DepartureTime
+	return dept == start || dept == start+static.Time(24*time.Hour)
+}
+
+// scheduledStarts returns the trips of a route scheduled to leave their
+// origin between from and to, ordered by departure.
+func scheduledStarts(feed *static.Feed, routeID string, from, to time.Time) []CancelledTrip {
+	var out []CancelledTrip
+	if to.Before(from) {
+		return out
+	}
+	// service days can run up to 48 hours
+	for day := feed.ServiceDate(from).AddDate(0, 0, -2); !day.After(to); day = day.AddDate(0, 0, 1) {
+		for _, trip := range feed.TripsOn(day, routeID) {
+			sts := feed.StopTimes[trip.ID]
+			if len(sts) == 0 || sts[0].DepartureTime == static.NoTime {
+				continue
+			}
+			dept := sts[0].DepartureTime.On(day)
+			if dept.Before(from) || dept.After(to) {
+				continue
+			}
+			out = append(out, CancelledTrip{
+				Trip:        trip,
+				ServiceDate: day,
+				Departure:   dept,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Departure.Before(out[j].Departure)
+	})
+	return out
+}
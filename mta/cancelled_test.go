@@ -0,0 +1,217 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testMatcher(t *testing.T) *TripMatcher {
+	t.Helper()
+	feed, err := static.LoadDir("../testdata/subway")
+	if err != nil {
+		t.Fatalf("unable to load test feed: %s", err)
+	}
+	return NewTripMatcher(feed)
+}
+
+func testReplacementFeed(t *testing.T, ts time.Time, end time.Time, trips ...*transit_realtime.TripDescriptor) *transit_realtime.FeedMessage {
+	t.Helper()
+	var stamp uint64
+	if !ts.IsZero() {
+		stamp = uint64(ts.Unix())
+	}
+	feed := &transit_realtime.FeedMessage{
+		Header: &transit_realtime.FeedHeader{
+			GtfsRealtimeVersion: proto.String("1.0"),
+			Timestamp:           proto.Uint64(stamp),
+		},
+	}
+	err := proto.SetExtension(feed.Header, transit_realtime.E_NyctFeedHeader, &transit_realtime.NyctFeedHeader{
+		NyctSubwayVersion: proto.String("1.0"),
+		TripReplacementPeriod: []*transit_realtime.TripReplacementPeriod{{
+			RouteId: proto.String("1"),
+			ReplacementPeriod: &transit_realtime.TimeRange{
+				End: proto.Uint64(uint64(end.Unix())),
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, td := range trips {
+		feed.Entity = append(feed.Entity, &transit_realtime.FeedEntity{
+			Id:         td.TripId,
+			TripUpdate: &transit_realtime.TripUpdate{Trip: td},
+		})
+	}
+	return feed
+}
+
+func testTrip(id, start string) *transit_realtime.TripDescriptor {
+	td := &transit_realtime.TripDescriptor{
+		TripId:    proto.String(id),
+		RouteId:   proto.String("1"),
+		StartDate: proto.String("20200218"),
+	}
+	if start != "" {
+		td.StartTime = proto.String(start)
+	}
+	return td
+}
+
+func TestCancelledTrips(t *testing.T) {
+	m := testMatcher(t)
+	now := time.Date(2020, 2, 18, 7, 55, 0, 0, m.location())
+	end := now.Add(30 * time.Minute)
+
+	const (
+		first    = "AFA19GEN-1037-Weekday-00_048000_1..S03R"
+		second   = "AFA19GEN-1037-Weekday-00_049000_1..S03R"
+		shortRun = "AFA19GEN-1037-Weekday-00_049000_1..S01R"
+		third    = "AFA19GEN-1037-Weekday-00_050000_1..S03R"
+	)
+
+	tests := []struct {
+		name  string
+		trips []*transit_realtime.TripDescriptor
+		want  []string
+	}{
+		{
+			name: "all running",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S03R", ""),
+				testTrip("049000_1..S03R", ""),
+				testTrip("049000_1..S01R", ""),
+				testTrip("050000_1..S03R", ""),
+			},
+		},
+		{
+			name: "missing",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S03R", ""),
+				testTrip("049000_1..S01R", ""),
+			},
+			want: []string{second, third},
+		},
+		{
+			name: "marked canceled",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S03R", ""),
+				testTrip("049000_1..S03R", ""),
+				testTrip("049000_1..S01R", ""),
+				func() *transit_realtime.TripDescriptor {
+					td := testTrip("050000_1..S03R", "")
+					td.ScheduleRelationship = transit_realtime.TripDescriptor_CANCELED.Enum()
+					return td
+				}(),
+			},
+			want: []string{third},
+		},
+		{
+			// without its path the train could be either 08:10 trip
+			name: "ambiguous path",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S03R", ""),
+				testTrip("049000_1..S", ""),
+				testTrip("050000_1..S03R", ""),
+			},
+			want: []string{shortRun, second},
+		},
+		{
+			name: "start time",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S", "08:00:00"),
+				testTrip("049000_1..S03R", ""),
+				testTrip("049000_1..S01R", ""),
+				testTrip("050000_1..S02R", "08:20:00"),
+			},
+		},
+		{
+			name: "wrong start time",
+			trips: []*transit_realtime.TripDescriptor{
+				testTrip("048000_1..S03R", ""),
+				testTrip("049000_1..S03R", ""),
+				testTrip("049000_1..S01R", ""),
+				testTrip("050000_1..S02R", "08:21:00"),
+			},
+			want: []string{third},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := CancelledTrips(testReplacementFeed(t, now, end, tt.trips...), m, now)
+			if len(periods) != 1 {
+				t.Fatalf("got %d periods, want 1", len(periods))
+			}
+			p := periods[0]
+			if p.RouteID != "1" || !p.Start.Equal(now) || !p.End.Equal(end) {
+				t.Errorf("got period %s from %s to %s, want 1 from %s to %s", p.RouteID, p.Start, p.End, now, end)
+			}
+			var got []string
+			for _, c := range p.Cancelled {
+				got = append(got, c.Trip.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cancelled %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCancelledTripsWithoutTimestamp(t *testing.T) {
+	m := testMatcher(t)
+	now := time.Date(2020, 2, 18, 8, 5, 0, 0, m.location())
+	end := now.Add(30 * time.Minute)
+	running := testTrip("049000_1..S03R", "")
+
+	tests := []struct {
+		name      string
+		timestamp time.Time
+		wantStart time.Time
+		want      []string
+	}{
+		{
+			name:      "without timestamp",
+			wantStart: now,
+			want: []string{
+				"AFA19GEN-1037-Weekday-00_049000_1..S01R",
+				"AFA19GEN-1037-Weekday-00_050000_1..S03R",
+			},
+		},
+		{
+			// the feed's own timestamp wins
+			name:      "with timestamp",
+			timestamp: now.Add(-10 * time.Minute),
+			wantStart: now.Add(-10 * time.Minute),
+			want: []string{
+				"AFA19GEN-1037-Weekday-00_048000_1..S03R",
+				"AFA19GEN-1037-Weekday-00_049000_1..S01R",
+				"AFA19GEN-1037-Weekday-00_050000_1..S03R",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := CancelledTrips(testReplacementFeed(t, tt.timestamp, end, running), m, now)
+			if len(periods) != 1 {
+				t.Fatalf("got %d periods, want 1", len(periods))
+			}
+			if start := periods[0].Start; !start.Equal(tt.wantStart) {
+				t.Errorf("period starts at %s, want %s", start, tt.wantStart)
+			}
+			var got []string
+			for _, c := range periods[0].Cancelled {
+				got = append(got, c.Trip.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cancelled %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
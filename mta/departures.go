@@ -0,0 +1,109 @@
+package mta
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// DepartureSlack is how far in the future a stop's last prediction may be
+// when it drops out of a feed and still count as departed. Stops dropped
+// earlier than that were more likely rerouted or cancelled.
+var DepartureSlack = 2 * time.Minute
+
+// departures notices trains leaving stops across snapshots: a stop that had a
+// prediction in one snapshot and is missing from the next has been departed.
+type departures struct {
+	// trip ID => stop ID => last prediction for the stop
+	pending map[string]map[string]departure
+}
+
+// departure is the last word from the feed about a train at a stop.
+type departure struct {
+	tripID string
+	trip   *transit_realtime.TripUpdate
+	update *transit_realtime.TripUpdate_StopTimeUpdate
+	// seen is when the snapshot carrying update was taken.
+	seen time.Time
+}
+
+func newDepartures() departures {
+	return departures{pending: map[string]map[string]departure{}}
+}
+
+// update will record a snapshot and return the stops departed since the last
+// one, ordered by their last predicted time.
+func (d departures) update(snap *Snapshot, now time.Time) []departure {
+	var out []departure
+	for tripID, pending := range d.pending {
+		tu, ok := snap.Trips[tripID]
+		if !ok && feedMissing(snap, pending) {
+			// the trip's feed could not be fetched, so wait to hear more
+			continue
+		}
+		listed := map[string]bool{}
+		for _, upd := range tu.GetStopTimeUpdate() {
+			listed[upd.GetStopId()] = true
+		}
+		for stopID, dep := range pending {
+			if listed[stopID] {
+				continue
+			}
+			if p, ok := predict(dep.update, false); ok && !p.Time.After(now.Add(DepartureSlack)) {
+				out = append(out, dep)
+			}
+			delete(pending, stopID)
+		}
+		if len(pending) == 0 {
+			delete(d.pending, tripID)
+		}
+	}
+
+	for tripID, tu := range snap.Trips {
+		if tu.GetTrip().GetScheduleRelationship() == transit_realtime.TripDescriptor_CANCELED {
+			delete(d.pending, tripID)
+			continue
+		}
+		for _, upd := range tu.GetStopTimeUpdate() {
+			if upd.GetScheduleRelationship() == transit_realtime.TripUpdate_StopTimeUpdate_SKIPPED {
+				delete(d.pending[tripID], upd.GetStopId())
+				continue
+			}
+			if _, ok := predict(upd, false); !ok {
+				continue
+			}
+			if d.pending[tripID] == nil {
+				d.pending[tripID] = map[string]departure{}
+			}
+			d.pending[tripID][upd.GetStopId()] = departure{tripID: tripID, trip: tu, update: upd, seen: now}
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		ti, _ := predict(out[i].update, false)
+		tj, _ := predict(out[j].update, false)
+		if !ti.Time.Equal(tj.Time) {
+			return ti.Time.Before(tj.Time)
+		}
+		if out[i].tripID != out[j].tripID {
+			return out[i].tripID < out[j].tripID
+		}
+		return out[i].update.GetStopId() < out[j].update.GetStopId()
+	})
+	return out
+}
+
+// feedMissing reports whether the feed carrying a trip is absent from the
+// snapshot, such as when it failed to fetch.
+func feedMissing(snap *Snapshot, pending map[string]departure) bool {
+	for _, dep := range pending {
+		ft, ok := FeedTypeForRoute(dep.trip.GetTrip().GetRouteId())
+		if !ok {
+			return false
+		}
+		_, ok = snap.Feeds[ft]
+		return !ok
+	}
+	return false
+}
@@ -1,7 +1,6 @@
 package mta
 
 import (
-	"sort"
 	"strings"
 	"time"
 
@@ -24,11 +23,10 @@ func Trains(f *transit_realtime.FeedMessage, stopId, line string) (alerts []*tra
 					}
 				}
 			}
-			if ent.Alert != nil {
-				alerts = append(alerts, ent.Alert)
-			}
 		}
-
+		if ent.Alert != nil {
+			alerts = append(alerts, ent.Alert)
+		}
 	}
 	return alerts, northbound, southbound
 }
@@ -43,40 +41,18 @@ func FeedNextTrainTimes(f *transit_realtime.FeedMessage, stopId, line string) (a
 }
 
 // NextTrainTimes will extract the departure times from the given
-// update slice, order and return them.
+// update slice, order and return the next five. Updates without a predicted
+// departure, such as a trip's last stop, are skipped.
 func NextTrainTimes(updates []*transit_realtime.TripUpdate_StopTimeUpdate) []time.Time {
-	var times []time.Time
-
+	var departing []*transit_realtime.TripUpdate_StopTimeUpdate
 	for _, upd := range updates {
-		if upd.Departure == nil {
-			continue
-		}
-		unix := *upd.Departure.Time
-		if upd.Departure.Delay != nil {
-			unix += int64(*upd.Departure.Delay * 1000)
-		}
-		dept := time.Unix(unix, 0)
-		if dept.After(time.Now()) {
-			times = append(times, dept)
+		if upd.GetDeparture().GetTime() != 0 {
+			departing = append(departing, upd)
 		}
 	}
-	sort.Sort(timeSlice(times))
-	if len(times) > 5 {
-		times = times[:5]
+	var times []time.Time
+	for _, p := range Predictions(departing, PredictionOptions{Limit: 5}) {
+		times = append(times, p.Time)
 	}
 	return times
 }
-
-type timeSlice []time.Time
-
-func (t timeSlice) Len() int {
-	return len(t)
-}
-
-func (t timeSlice) Less(i, j int) bool {
-	return t[i].Before(t[j])
-}
-
-func (t timeSlice) Swap(i, j int) {
-	t[i], t[j] = t[j], t[i]
-}
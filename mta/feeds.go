@@ -0,0 +1,220 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+const (
+	apiBaseURL = "https://api-endpoint.mta.info/Dataservice/mtagtfsfeeds/"
+	busBaseURL = "https://gtfsrt.prod.obanyc.com/"
+)
+
+// FeedFormat is the encoding of a feed.
+type FeedFormat string
+
+const (
+	FormatGTFSRealtime FeedFormat = "gtfs-rt"
+	FormatJSON         FeedFormat = "json"
+)
+
+// FeedKind describes what a feed carries.
+type FeedKind string
+
+const (
+	KindTrips            FeedKind = "trips"
+	KindVehiclePositions FeedKind = "vehicle-positions"
+	KindAlerts           FeedKind = "alerts"
+	KindElevators        FeedKind = "elevators"
+)
+
+// Feed describes one of the MTA's realtime feeds.
+type Feed struct {
+	Name string
+	// Agency is the agency_id of the feed's routes. It is empty for feeds
+	// spanning several agencies.
+	Agency string
+	URL    string
+	Format FeedFormat
+	Kind   FeedKind
+
+	// KeyParam is the query parameter the API key is sent in. If empty, the
+	// key is sent in the x-api-key header.
+	KeyParam string
+
+	// Routes lists the route IDs the feed carries. It is empty for feeds
+	// that carry every route of their agency.
+	Routes []string
+}
+
+// Feeds is a registry of every MTA realtime feed.
+var Feeds = []Feed{
+	subwayFeed("nyct/gtfs", NumberedFeed, "1", "2", "3", "4", "5", "5X", "6", "6X", "GS"),
+	subwayFeed("nyct/gtfs-ace", BlueFeed, "A", "C", "E", "H", "FS"),
+	subwayFeed("nyct/gtfs-bdfm", OrangeFeed, "B", "D", "F", "FX", "M"),
+	subwayFeed("nyct/gtfs-g", GFeed, "G"),
+	subwayFeed("nyct/gtfs-jz", BrownFeed, "J", "Z"),
+	subwayFeed("nyct/gtfs-nqrw", YellowFeed, "N", "Q", "R", "W"),
+	subwayFeed("nyct/gtfs-l", LFeed, "L"),
+	subwayFeed("nyct/gtfs-7", SevenFeed, "7", "7X"),
+	subwayFeed("nyct/gtfs-si", StatenIslandFeed, "SI"),
+	{
+		Name:   "lirr/gtfs-lirr",
+		Agency: "LI",
+		URL:    apiBaseURL + "lirr%2Fgtfs-lirr",
+		Format: FormatGTFSRealtime,
+		Kind:   KindTrips,
+	},
+	{
+		Name:   "mnr/gtfs-mnr",
+		Agency: "MNR",
+		URL:    apiBaseURL + "mnr%2Fgtfs-mnr",
+		Format: FormatGTFSRealtime,
+		Kind:   KindTrips,
+	},
+	alertsFeed("camsys/all-alerts", ""),
+	alertsFeed("camsys/subway-alerts", "MTA NYCT"),
+	alertsFeed("camsys/bus-alerts", "MTABC"),
+	alertsFeed("camsys/lirr-alerts", "LI"),
+	alertsFeed("camsys/mnr-alerts", "MNR"),
+	{
+		Name:   "nyct/nyct_ene",
+		Agency: "MTA NYCT",
+		URL:    apiBaseURL + "nyct%2Fnyct_ene.json",
+		Format: FormatJSON,
+		Kind:   KindElevators,
+	},
+	{
+		Name:   "nyct/nyct_ene_upcoming",
+		Agency: "MTA NYCT",
+		URL:    apiBaseURL + "nyct%2Fnyct_ene_upcoming.json",
+		Format: FormatJSON,
+		Kind:   KindElevators,
+	},
+	{
+		Name:   "nyct/nyct_ene_equipments",
+		Agency: "MTA NYCT",
+		URL:    apiBaseURL + "nyct%2Fnyct_ene_equipments.json",
+		Format: FormatJSON,
+		Kind:   KindElevators,
+	},
+	{
+		Name:     "bus/tripUpdates",
+		URL:      busBaseURL + "tripUpdates",
+		Format:   FormatGTFSRealtime,
+		Kind:     KindTrips,
+		KeyParam: "key",
+	},
+	{
+		Name:     "bus/vehiclePositions",
+		URL:      busBaseURL + "vehiclePositions",
+		Format:   FormatGTFSRealtime,
+		Kind:     KindVehiclePositions,
+		KeyParam: "key",
+	},
+	{
+		Name:     "bus/alerts",
+		URL:      busBaseURL + "alerts",
+		Format:   FormatGTFSRealtime,
+		Kind:     KindAlerts,
+		KeyParam: "key",
+	},
+}
+
+func subwayFeed(name string, ft FeedType, routes ...string) Feed {
+	return Feed{
+		Name:   name,
+		Agency: "MTA NYCT",
+		URL:    ft.URL(),
+		Format: FormatGTFSRealtime,
+		Kind:   KindTrips,
+		Routes: routes,
+	}
+}
+
+func alertsFeed(name, agency string) Feed {
+	return Feed{
+		Name:   name,
+		Agency: agency,
+		URL:    apiBaseURL + url.PathEscape(name),
+		Format: FormatGTFSRealtime,
+		Kind:   KindAlerts,
+	}
+}
+
+// FeedByName will return the registered feed with the given name, like
+// "nyct/gtfs-ace".
+func FeedByName(name string) (Feed, bool) {
+	for _, f := range Feeds {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Feed{}, false
+}
+
+// FeedForRoute will return the subway or Staten Island Railway trip feed
+// carrying a route, such as "A", "7X" or "SI". LIRR and Metro-North route IDs
+// overlap with the subway's so they are not looked up here.
+func FeedForRoute(routeID string) (Feed, bool) {
+	for _, f := range Feeds {
+		if f.Agency != "MTA NYCT" || f.Kind != KindTrips {
+			continue
+		}
+		for _, r := range f.Routes {
+			if r == routeID {
+				return f, true
+			}
+		}
+	}
+	return Feed{}, false
+}
+
+// FeedTypeForRoute will return the subway FeedType carrying a route.
+func FeedTypeForRoute(routeID string) (FeedType, bool) {
+	f, ok := FeedForRoute(routeID)
+	if !ok {
+		return "", false
+	}
+	for _, ft := range FeedTypes {
+		if ft.URL() == f.URL {
+			return ft, true
+		}
+	}
+	return "", false
+}
+
+// GetFeed will fetch and parse a GTFS-realtime feed from the registry. If hc
+// is nil, http.DefaultClient is used. Errors are reported as they are by
+// GetNYCSubwayFeed.
+func GetFeed(ctx context.Context, hc *http.Client, key string, f Feed) (*transit_realtime.FeedMessage, error) {
+	if f.Format != FormatGTFSRealtime {
+		return nil, fmt.Errorf("feed %s is %s, not GTFS-realtime", f.Name, f.Format)
+	}
+	if f.KeyParam == "" {
+		return getFeed(ctx, hc, key, f.URL)
+	}
+	u, err := url.Parse(f.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid feed URL", err)
+	}
+	q := u.Query()
+	q.Set(f.KeyParam, key)
+	u.RawQuery = q.Encode()
+
+	feed, err := getFeed(ctx, hc, "", u.String())
+	var ferr *FeedError
+	if errors.As(err, &ferr) {
+		// keep the key out of error messages and logs
+		ferr.URL = f.URL
+		if uerr, ok := ferr.Cause.(*url.Error); ok {
+			ferr.Cause = &url.Error{Op: uerr.Op, URL: f.URL, Err: uerr.Err}
+		}
+	}
+	return feed, err
+}
@@ -0,0 +1,87 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestGetFeedRedactsKey(t *testing.T) {
+	const key = "s3cr3t-key"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key") != key {
+			t.Errorf("request sent key %q, want %q", r.URL.Query().Get("key"), key)
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	failing := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})}
+
+	tests := []struct {
+		name    string
+		hc      *http.Client
+		url     string
+		wantErr error
+	}{
+		{"transport failure", failing, "https://bus.example.com/tripUpdates", ErrRequest},
+		{"rejected", nil, srv.URL + "/tripUpdates", ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Feed{Name: "bus/tripUpdates", URL: tt.url, Format: FormatGTFSRealtime, KeyParam: "key"}
+			_, err := GetFeed(context.Background(), tt.hc, key, f)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if strings.Contains(err.Error(), key) {
+				t.Errorf("error leaks the key: %s", err)
+			}
+			var ferr *FeedError
+			if !errors.As(err, &ferr) || ferr.URL != tt.url {
+				t.Errorf("got error %#v, want a *FeedError for %s", err, tt.url)
+			}
+		})
+	}
+}
+
+func TestFeedForRoute(t *testing.T) {
+	tests := []struct {
+		routeID  string
+		wantName string
+		wantType FeedType
+		wantOK   bool
+	}{
+		{"1", "nyct/gtfs", NumberedFeed, true},
+		{"7X", "nyct/gtfs-7", SevenFeed, true},
+		{"FS", "nyct/gtfs-ace", BlueFeed, true},
+		{"SI", "nyct/gtfs-si", StatenIslandFeed, true},
+		{"Babylon", "", "", false},
+	}
+	for _, tt := range tests {
+		f, ok := FeedForRoute(tt.routeID)
+		if ok != tt.wantOK || f.Name != tt.wantName {
+			t.Errorf("FeedForRoute(%q) = %q, %t, want %q, %t", tt.routeID, f.Name, ok, tt.wantName, tt.wantOK)
+		}
+		ft, ok := FeedTypeForRoute(tt.routeID)
+		if ok != tt.wantOK || ft != tt.wantType {
+			t.Errorf("FeedTypeForRoute(%q) = %q, %t, want %q, %t", tt.routeID, ft, ok, tt.wantType, tt.wantOK)
+		}
+		if tt.wantOK {
+			if byName, ok := FeedByName(tt.wantName); !ok || byName.URL != f.URL {
+				t.Errorf("FeedByName(%q) = %+v, %t", tt.wantName, byName, ok)
+			}
+		}
+	}
+}
@@ -2,9 +2,13 @@ package mta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
@@ -22,35 +26,167 @@ const (
 	GFeed        FeedType = "-g"
 	SevenFeed    FeedType = "-7"
 	BrownFeed    FeedType = "-jz"
+
+	StatenIslandFeed FeedType = "-si"
 )
 
-// GetSubwayFeed takes an API key generated from https://api.mta.info and a type
-// specifying which subway feed and it will return a transit_realtime.FeedMessage with
-// NYCT extensions.
+// FeedTypes lists every subway feed.
+var FeedTypes = []FeedType{
+	NumberedFeed,
+	BlueFeed,
+	YellowFeed,
+	OrangeFeed,
+	LFeed,
+	GFeed,
+	SevenFeed,
+	BrownFeed,
+	StatenIslandFeed,
+}
+
+// URL returns the endpoint of the feed.
+func (ft FeedType) URL() string {
+	return apiBaseURL + "nyct%2Fgtfs" + string(ft)
+}
+
+// MaxFeedAge is how old a feed's header timestamp may be before the feed is
+// reported as stale. The NYCT feeds are regenerated every 30 seconds.
+var MaxFeedAge = 5 * time.Minute
+
+var (
+	// ErrRequest is returned when the feed cannot be requested or its
+	// response cannot be read, such as on a network failure.
+	ErrRequest = errors.New("feed request failed")
+	// ErrUnauthorized is returned when the API key is missing or rejected.
+	ErrUnauthorized = errors.New("feed request unauthorized")
+	// ErrRateLimited is returned when the API is throttling requests.
+	ErrRateLimited = errors.New("feed request rate limited")
+	// ErrUpstream is returned when the API responds with a server error or
+	// with something other than a feed.
+	ErrUpstream = errors.New("feed upstream error")
+	// ErrMalformedFeed is returned when the response cannot be parsed as a
+	// GTFS-realtime feed.
+	ErrMalformedFeed = errors.New("malformed feed")
+	// ErrStaleFeed is returned when the feed's timestamp is older than
+	// MaxFeedAge.
+	ErrStaleFeed = errors.New("stale feed")
+)
+
+// FeedError describes a failed feed fetch. Use errors.Is with the Err
+// values above to tell failures apart. errors.Is also matches the Cause, so
+// a cancelled fetch still reports context.Canceled.
+type FeedError struct {
+	URL        string
+	StatusCode int
+	// RetryAfter is set when a rate limited response says when to retry.
+	RetryAfter time.Duration
+
+	Err error
+	// Cause is the underlying error, if any, such as a protobuf error.
+	Cause error
+}
+
+func (e *FeedError) Error() string {
+	msg := e.Err.Error()
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (HTTP %d)", e.StatusCode)
+	}
+	if e.Cause != nil {
+		msg += ": " + e.Cause.Error()
+	}
+	return msg
+}
+
+func (e *FeedError) Unwrap() error {
+	return e.Err
+}
+
+func (e *FeedError) Is(target error) bool {
+	return e.Cause != nil && errors.Is(e.Cause, target)
+}
+
+// GetNYCSubwayFeed takes an API key generated from https://api.mta.info and a
+// type specifying which subway feed and it will return a
+// transit_realtime.FeedMessage with NYCT extensions. If hc is nil,
+// http.DefaultClient is used.
+//
+// Failures are reported as a *FeedError. A feed older than MaxFeedAge is
+// still returned, along with an error wrapping ErrStaleFeed.
 func GetNYCSubwayFeed(ctx context.Context, hc *http.Client, key string, ft FeedType) (*transit_realtime.FeedMessage, error) {
-	r, err := http.NewRequest(http.MethodGet,
-		"https://api-endpoint.mta.info/Dataservice/mtagtfsfeeds/nyct%2Fgtfs"+string(ft), nil)
+	return getFeed(ctx, hc, key, ft.URL())
+}
+
+func getFeed(ctx context.Context, hc *http.Client, key, url string) (*transit_realtime.FeedMessage, error) {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	r, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("%w: unable to build request", err)
+		return nil, &FeedError{URL: url, Err: ErrRequest, Cause: err}
+	}
+	r = r.WithContext(ctx)
+	if key != "" {
+		r.Header.Set("x-api-key", key)
 	}
-	r.Header.Set("x-api-key", key)
 
-	resp, err := http.DefaultClient.Do(r)
+	resp, err := hc.Do(r)
 	if err != nil {
-		return nil, fmt.Errorf("%w: unable to get feed", err)
+		return nil, &FeedError{URL: url, Err: ErrRequest, Cause: err}
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("%w: unable to read feed", err)
+		return nil, &FeedError{URL: url, StatusCode: resp.StatusCode, Err: ErrRequest, Cause: err}
+	}
+
+	if ferr := checkResponse(resp, body); ferr != nil {
+		ferr.URL = url
+		return nil, ferr
 	}
 
 	var feed transit_realtime.FeedMessage
 	err = proto.Unmarshal(body, &feed)
 	if err != nil {
-		return nil, fmt.Errorf("%w: unable to parse feed", err)
+		return nil, &FeedError{URL: url, StatusCode: resp.StatusCode, Err: ErrMalformedFeed, Cause: err}
+	}
+	if feed.GetHeader().GetGtfsRealtimeVersion() == "" {
+		return nil, &FeedError{URL: url, StatusCode: resp.StatusCode, Err: ErrMalformedFeed,
+			Cause: errors.New("missing feed header")}
 	}
 
+	if ts := feed.GetHeader().GetTimestamp(); ts != 0 {
+		age := time.Since(time.Unix(int64(ts), 0))
+		if age > MaxFeedAge {
+			return &feed, &FeedError{URL: url, StatusCode: resp.StatusCode, Err: ErrStaleFeed,
+				Cause: fmt.Errorf("feed is %s old", age.Round(time.Second))}
+		}
+	}
 	return &feed, nil
 }
+
+func checkResponse(resp *http.Response, body []byte) *FeedError {
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return &FeedError{StatusCode: resp.StatusCode, Err: ErrUnauthorized}
+	case resp.StatusCode == http.StatusTooManyRequests:
+		ferr := &FeedError{StatusCode: resp.StatusCode, Err: ErrRateLimited}
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			ferr.RetryAfter = time.Duration(secs) * time.Second
+		}
+		return ferr
+	case resp.StatusCode != http.StatusOK:
+		return &FeedError{StatusCode: resp.StatusCode, Err: ErrUpstream}
+	}
+
+	// error pages have been served with a 200 before
+	ct := resp.Header.Get("Content-Type")
+	if strings.HasPrefix(ct, "text/html") || strings.HasPrefix(ct, "application/json") {
+		return &FeedError{StatusCode: resp.StatusCode, Err: ErrUpstream,
+			Cause: fmt.Errorf("unexpected content type %q", ct)}
+	}
+	if len(body) == 0 {
+		return &FeedError{StatusCode: resp.StatusCode, Err: ErrMalformedFeed,
+			Cause: errors.New("empty response")}
+	}
+	return nil
+}
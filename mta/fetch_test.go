@@ -0,0 +1,128 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestGetFeed(t *testing.T) {
+	fresh, err := proto.Marshal(testFeedMessage(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := proto.Marshal(testFeedMessage(time.Now().Add(-time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		// url overrides the test server's
+		url string
+		ctx func() context.Context
+
+		wantErr   error
+		wantCause error
+		wantFeed  bool
+	}{
+		{
+			name: "ok",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fresh)
+			},
+			wantFeed: true,
+		},
+		{
+			name: "stale",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write(stale)
+			},
+			wantErr:  ErrStaleFeed,
+			wantFeed: true,
+		},
+		{
+			name: "unauthorized",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			},
+			wantErr: ErrUnauthorized,
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			wantErr: ErrUpstream,
+		},
+		{
+			name: "garbage",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("not a feed"))
+			},
+			wantErr: ErrMalformedFeed,
+		},
+		{
+			name:    "bad url",
+			url:     "://nowhere",
+			wantErr: ErrRequest,
+		},
+		{
+			name: "cancelled",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write(fresh)
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			wantErr:   ErrRequest,
+			wantCause: context.Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := tt.url
+			if tt.handler != nil {
+				srv := httptest.NewServer(tt.handler)
+				defer srv.Close()
+				url = srv.URL
+			}
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx()
+			}
+
+			feed, err := getFeed(ctx, nil, "key", url)
+			if (feed != nil) != tt.wantFeed {
+				t.Errorf("got feed %v, want a feed: %t", feed, tt.wantFeed)
+			}
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			var ferr *FeedError
+			if !errors.As(err, &ferr) {
+				t.Fatalf("got error %v, want a *FeedError", err)
+			}
+			if ferr.URL != url {
+				t.Errorf("error URL = %q, want %q", ferr.URL, url)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantCause != nil && !errors.Is(err, tt.wantCause) {
+				t.Errorf("got error %v, want cause %v", err, tt.wantCause)
+			}
+		})
+	}
+}
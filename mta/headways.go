@@ -0,0 +1,440 @@
+package mta
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+var (
+	// GapFactor is how many times longer than scheduled a headway must be to
+	// be reported as a gap.
+	GapFactor = 2.0
+	// MinGap is the shortest headway reported as a gap, so frequent service
+	// running a few minutes apart is not flagged.
+	MinGap = 10 * time.Minute
+
+	// BunchingFactor is the fraction of the scheduled headway a headway must
+	// fall under to be reported as bunching.
+	BunchingFactor = 0.25
+	// MaxBunching is the longest headway reported as bunching.
+	MaxBunching = 2 * time.Minute
+)
+
+// scheduleWindow is the span of scheduled arrivals averaged to find the
+// scheduled headway at a time.
+const scheduleWindow = time.Hour
+
+// HeadwayEventType is the kind of service problem a HeadwayEvent reports.
+type HeadwayEventType int
+
+const (
+	// GapDetected is a headway far longer than scheduled.
+	GapDetected HeadwayEventType = iota
+	// Bunching is trains running far closer together than scheduled.
+	Bunching
+)
+
+func (t HeadwayEventType) String() string {
+	switch t {
+	case GapDetected:
+		return "gap detected"
+	case Bunching:
+		return "bunching"
+	}
+	return "unknown"
+}
+
+// Headway is the time between two consecutive trains of a route arriving at
+// a station in the same direction.
+type Headway struct {
+	RouteID string
+	// Direction is "N" or "S".
+	Direction string
+	// StopID is the parent station.
+	StopID string
+
+	// LeadTripID arrived at LeadTime and TripID follows it at Time. An empty
+	// TripID means no following train is in the feed yet and Time is when
+	// the headway was measured.
+	LeadTripID string
+	LeadTime   time.Time
+	TripID     string
+	Time       time.Time
+
+	Headway time.Duration
+	// Scheduled is the average headway the static schedule calls for around
+	// Time. Zero means no scheduled service was found.
+	Scheduled time.Duration
+	// Predicted is true when the following train has not arrived yet and
+	// Time is its predicted arrival.
+	Predicted bool
+}
+
+// HeadwayEvent is a gap or bunching found by a HeadwayMonitor.
+type HeadwayEvent struct {
+	Type HeadwayEventType
+	Headway
+}
+
+// HeadwayMonitor measures headways at every station from successive
+// snapshots and compares them with the static schedule. Trains are observed
+// arriving at a stop when its prediction drops out of the feed, and trains
+// still on their way are placed by their predicted arrivals. It is safe for
+// concurrent use.
+type HeadwayMonitor struct {
+	m *TripMatcher
+
+	mu   sync.Mutex
+	deps departures
+	// observed arrivals within the last scheduleWindow, oldest first
+	observed map[headwayKey][]headwayArrival
+	// headways as of the last update
+	headways map[headwayKey][]Headway
+	// event key => time first reported
+	reported map[string]time.Time
+	// service date => scheduled arrivals, sorted
+	schedules map[string]map[headwayKey][]time.Time
+}
+
+type headwayKey struct {
+	route string
+	dir   string
+	stop  string
+}
+
+type headwayArrival struct {
+	tripID    string
+	at        time.Time
+	predicted bool
+}
+
+// NewHeadwayMonitor will create a monitor using the matcher's static feed for
+// scheduled headways.
+func NewHeadwayMonitor(m *TripMatcher) *HeadwayMonitor {
+	return &HeadwayMonitor{
+		m:         m,
+		deps:      newDepartures(),
+		observed:  map[headwayKey][]headwayArrival{},
+		headways:  map[headwayKey][]Headway{},
+		reported:  map[string]time.Time{},
+		schedules: map[string]map[headwayKey][]time.Time{},
+	}
+}
+
+// Update will record a snapshot, recompute headways and return the gaps and
+// bunching not reported by an earlier update, ordered by route, direction,
+// station and time. A gap behind a train is reported once, whether it was
+// first seen in predictions, arrivals or while still waiting on the next
+// train.
+func (h *HeadwayMonitor) Update(snap *Snapshot, now time.Time) []HeadwayEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, dep := range h.deps.update(snap, now) {
+		key, ok := newHeadwayKey(dep.trip.GetTrip().GetRouteId(), dep.update.GetStopId())
+		if !ok {
+			continue
+		}
+		p, _ := predict(dep.update, true)
+		h.observe(key, headwayArrival{tripID: dep.tripID, at: p.Time})
+	}
+	for key, arrs := range h.observed {
+		for len(arrs) > 0 && arrs[0].at.Before(now.Add(-scheduleWindow)) {
+			arrs = arrs[1:]
+		}
+		if len(arrs) == 0 {
+			delete(h.observed, key)
+			continue
+		}
+		h.observed[key] = arrs
+	}
+
+	// trains still on their way, keyed like observed
+	coming := map[headwayKey][]headwayArrival{}
+	for tripID, tu := range snap.Trips {
+		for _, upd := range tu.GetStopTimeUpdate() {
+			key, ok := newHeadwayKey(tu.GetTrip().GetRouteId(), upd.GetStopId())
+			if !ok {
+				continue
+			}
+			p, ok := predict(upd, true)
+			if !ok {
+				continue
+			}
+			coming[key] = append(coming[key], headwayArrival{tripID: tripID, at: p.Time, predicted: true})
+		}
+	}
+
+	h.headways = map[headwayKey][]Headway{}
+	for key := range h.observed {
+		h.headways[key] = h.measure(key, coming[key], now)
+	}
+	for key := range coming {
+		if _, ok := h.headways[key]; !ok {
+			h.headways[key] = h.measure(key, coming[key], now)
+		}
+	}
+
+	var out []HeadwayEvent
+	active := map[string]bool{}
+	for _, key := range h.keys() {
+		for _, hw := range h.headways[key] {
+			typ, ok := classify(hw)
+			if !ok {
+				continue
+			}
+			ek := strings.Join([]string{typ.String(), key.route, key.dir, key.stop, hw.LeadTripID}, "|")
+			if typ == Bunching {
+				ek += "|" + hw.TripID
+			}
+			active[ek] = true
+			if _, seen := h.reported[ek]; seen {
+				continue
+			}
+			h.reported[ek] = now
+			out = append(out, HeadwayEvent{Type: typ, Headway: hw})
+		}
+	}
+	for ek, at := range h.reported {
+		if !active[ek] && at.Before(now.Add(-scheduleWindow)) {
+			delete(h.reported, ek)
+		}
+	}
+	return out
+}
+
+// Headways will return the headways as of the last update for a route and
+// direction ("N" or "S"), ordered by station and time. Either may be empty to
+// match any.
+func (h *HeadwayMonitor) Headways(routeID, direction string) []Headway {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var out []Headway
+	for _, key := range h.keys() {
+		if (routeID != "" && key.route != routeID) || (direction != "" && key.dir != direction) {
+			continue
+		}
+		out = append(out, h.headways[key]...)
+	}
+	return out
+}
+
+func (h *HeadwayMonitor) observe(key headwayKey, arr headwayArrival) {
+	arrs := h.observed[key]
+	for _, a := range arrs {
+		if a.tripID == arr.tripID {
+			return
+		}
+	}
+	arrs = append(arrs, arr)
+	sort.SliceStable(arrs, func(i, j int) bool {
+		return arrs[i].at.Before(arrs[j].at)
+	})
+	h.observed[key] = arrs
+}
+
+// measure lines up the observed and predicted arrivals at a station and
+// returns the headways between them, plus a trailing open headway when no
+// train has followed the last one to arrive.
+func (h *HeadwayMonitor) measure(key headwayKey, coming []headwayArrival, now time.Time) []Headway {
+	arrived := map[string]bool{}
+	arrs := append([]headwayArrival{}, h.observed[key]...)
+	for _, a := range arrs {
+		arrived[a.tripID] = true
+	}
+	for _, a := range coming {
+		if !arrived[a.tripID] {
+			arrs = append(arrs, a)
+		}
+	}
+	sort.SliceStable(arrs, func(i, j int) bool {
+		return arrs[i].at.Before(arrs[j].at)
+	})
+
+	var out []Headway
+	for i := 1; i < len(arrs); i++ {
+		lead, next := arrs[i-1], arrs[i]
+		out = append(out, Headway{
+			RouteID:    key.route,
+			Direction:  key.dir,
+			StopID:     key.stop,
+			LeadTripID: lead.tripID,
+			LeadTime:   lead.at,
+			TripID:     next.tripID,
+			Time:       next.at,
+			Headway:    next.at.Sub(lead.at),
+			Scheduled:  h.scheduled(key, next.at),
+			Predicted:  next.predicted,
+		})
+	}
+
+	// a wait past the last train to arrive is only a headway if something
+	// was scheduled to come in the meantime
+	if n := len(arrs); n > 0 && !arrs[n-1].predicted && now.After(arrs[n-1].at) {
+		last := arrs[n-1]
+		if h.scheduledBetween(key, last.at, now) {
+			out = append(out, Headway{
+				RouteID:    key.route,
+				Direction:  key.dir,
+				StopID:     key.stop,
+				LeadTripID: last.tripID,
+				LeadTime:   last.at,
+				Time:       now,
+				Headway:    now.Sub(last.at),
+				Scheduled:  h.scheduled(key, now),
+			})
+		}
+	}
+	return out
+}
+
+// classify decides whether a headway is a gap or bunching.
+func classify(hw Headway) (HeadwayEventType, bool) {
+	if hw.Scheduled <= 0 {
+		return 0, false
+	}
+	gap := time.Duration(GapFactor * float64(hw.Scheduled))
+	if gap < MinGap {
+		gap = MinGap
+	}
+	if hw.Headway >= gap {
+		return GapDetected, true
+	}
+	// an open headway can still grow into a gap but can not be bunched
+	if hw.TripID == "" {
+		return 0, false
+	}
+	bunched := time.Duration(BunchingFactor * float64(hw.Scheduled))
+	if bunched > MaxBunching {
+		bunched = MaxBunching
+	}
+	if hw.Headway <= bunched {
+		return Bunching, true
+	}
+	return 0, false
+}
+
+// scheduled returns the average scheduled headway over the window around t.
+func (h *HeadwayMonitor) scheduled(key headwayKey, t time.Time) time.Duration {
+	times := h.scheduleAround(key, t)
+	from, to := t.Add(-scheduleWindow/2), t.Add(scheduleWindow/2)
+	var in []time.Time
+	for _, st := range times {
+		if !st.Before(from) && !st.After(to) {
+			in = append(in, st)
+		}
+	}
+	if len(in) >= 2 {
+		return in[len(in)-1].Sub(in[0]) / time.Duration(len(in)-1)
+	}
+
+	// sparse service, so use the scheduled interval t falls in
+	i := sort.Search(len(times), func(i int) bool {
+		return !times[i].Before(t)
+	})
+	if i == 0 || i == len(times) {
+		return 0
+	}
+	return times[i].Sub(times[i-1])
+}
+
+// scheduledBetween reports whether a train is scheduled to arrive after from
+// and no later than to.
+func (h *HeadwayMonitor) scheduledBetween(key headwayKey, from, to time.Time) bool {
+	for _, st := range h.scheduleAround(key, to) {
+		if st.After(from) && !st.After(to) {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleAround returns the scheduled arrivals at a station from the
+// service days that may run at t, sorted.
+func (h *HeadwayMonitor) scheduleAround(key headwayKey, t time.Time) []time.Time {
+	if h.m == nil {
+		return nil
+	}
+	day := h.m.feed.ServiceDate(t.In(h.m.location()))
+	var out []time.Time
+	for _, d := range []time.Time{day.AddDate(0, 0, -1), day} {
+		out = append(out, h.schedule(d)[key]...)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Before(out[j])
+	})
+	return out
+}
+
+// schedule indexes the scheduled arrivals of a service date, keeping the two
+// most recent days.
+func (h *HeadwayMonitor) schedule(day time.Time) map[headwayKey][]time.Time {
+	dk := day.Format(static.DateFormat)
+	if sched, ok := h.schedules[dk]; ok {
+		return sched
+	}
+
+	sched := map[headwayKey][]time.Time{}
+	for _, trip := range h.m.feed.TripsOn(day, "") {
+		for _, st := range h.m.feed.StopTimes[trip.ID] {
+			key, ok := newHeadwayKey(trip.RouteID, st.StopID)
+			if !ok {
+				continue
+			}
+			at := st.ArrivalTime
+			if at == static.NoTime {
+				at = st.DepartureTime
+			}
+			if at == static.NoTime {
+				continue
+			}
+			sched[key] = append(sched[key], at.On(day))
+		}
+	}
+	for _, times := range sched {
+		sort.Slice(times, func(i, j int) bool {
+			return times[i].Before(times[j])
+		})
+	}
+
+	for k := range h.schedules {
+		if k < day.AddDate(0, 0, -1).Format(static.DateFormat) {
+			delete(h.schedules, k)
+		}
+	}
+	h.schedules[dk] = sched
+	return sched
+}
+
+// keys returns the stations with headways, sorted.
+func (h *HeadwayMonitor) keys() []headwayKey {
+	keys := make([]headwayKey, 0, len(h.headways))
+	for key := range h.headways {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		if keys[i].dir != keys[j].dir {
+			return keys[i].dir < keys[j].dir
+		}
+		return keys[i].stop < keys[j].stop
+	})
+	return keys
+}
+
+// newHeadwayKey splits a platform ID like "127N" into its station and
+// direction.
+func newHeadwayKey(routeID, stopID string) (headwayKey, bool) {
+	n := len(stopID)
+	if routeID == "" || n < 2 || (stopID[n-1] != 'N' && stopID[n-1] != 'S') {
+		return headwayKey{}, false
+	}
+	return headwayKey{route: routeID, dir: stopID[n-1:], stop: stopID[:n-1]}, true
+}
@@ -0,0 +1,110 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func TestHeadwayMonitor(t *testing.T) {
+	m := testMatcher(t)
+	at := func(hour, min int) time.Time {
+		return time.Date(2020, 2, 18, hour, min, 0, 0, m.location())
+	}
+	// trip ID => predicted arrival at 101S
+	type step struct {
+		now    time.Time
+		trains map[string]time.Time
+		want   []HeadwayEventType
+	}
+
+	// route 1 is scheduled at 101 at 08:00, 08:10, 08:10 and 08:20, so
+	// about every 6m40s around 08:10
+	tests := []struct {
+		name  string
+		steps []step
+		// wantHeadways are those as of the last step
+		wantHeadways []time.Duration
+	}{
+		{
+			name: "on schedule",
+			steps: []step{
+				{at(7, 50), map[string]time.Time{"048000_1..S03R": at(8, 0), "049000_1..S03R": at(8, 10)}, nil},
+			},
+			wantHeadways: []time.Duration{10 * time.Minute},
+		},
+		{
+			name: "predicted gap",
+			steps: []step{
+				{at(7, 50), map[string]time.Time{"048000_1..S03R": at(8, 0), "049000_1..S03R": at(8, 15)},
+					[]HeadwayEventType{GapDetected}},
+				// reported once
+				{at(7, 51), map[string]time.Time{"048000_1..S03R": at(8, 0), "049000_1..S03R": at(8, 16)}, nil},
+			},
+			wantHeadways: []time.Duration{16 * time.Minute},
+		},
+		{
+			name: "bunching",
+			steps: []step{
+				{at(7, 50), map[string]time.Time{"048000_1..S03R": at(8, 0), "049000_1..S03R": at(8, 1)},
+					[]HeadwayEventType{Bunching}},
+			},
+			wantHeadways: []time.Duration{time.Minute},
+		},
+		{
+			// the train arrives and nothing follows while trains are due
+			name: "open gap",
+			steps: []step{
+				{at(7, 58), map[string]time.Time{"048000_1..S03R": at(8, 0)}, nil},
+				{at(8, 5), map[string]time.Time{}, nil},
+				{at(8, 18), map[string]time.Time{}, []HeadwayEventType{GapDetected}},
+			},
+			wantHeadways: []time.Duration{18 * time.Minute},
+		},
+		{
+			name: "arrived on schedule",
+			steps: []step{
+				{at(7, 58), map[string]time.Time{"048000_1..S03R": at(8, 0), "049000_1..S03R": at(8, 10)}, nil},
+				{at(8, 2), map[string]time.Time{"049000_1..S03R": at(8, 10)}, nil},
+				{at(8, 12), map[string]time.Time{}, nil},
+			},
+			wantHeadways: []time.Duration{10 * time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeadwayMonitor(m)
+			for i, st := range tt.steps {
+				feed := testFeedMessage(st.now)
+				for tripID, arr := range st.trains {
+					feed.Entity = append(feed.Entity, testTripUpdate(tripID, map[string]time.Time{"101S": arr}))
+				}
+				snap := NewSnapshot(map[FeedType]*transit_realtime.FeedMessage{NumberedFeed: feed})
+
+				var got []HeadwayEventType
+				for _, ev := range h.Update(snap, st.now) {
+					if ev.RouteID != "1" || ev.Direction != "S" || ev.StopID != "101" {
+						t.Errorf("step %d: event at %s %s %s", i, ev.RouteID, ev.Direction, ev.StopID)
+					}
+					got = append(got, ev.Type)
+				}
+				if !reflect.DeepEqual(got, st.want) {
+					t.Errorf("step %d: got events %v, want %v", i, got, st.want)
+				}
+			}
+
+			var got []time.Duration
+			for _, hw := range h.Headways("1", "S") {
+				if hw.Scheduled <= 0 {
+					t.Errorf("headway %+v has no scheduled headway", hw)
+				}
+				got = append(got, hw.Headway)
+			}
+			if !reflect.DeepEqual(got, tt.wantHeadways) {
+				t.Errorf("got headways %v, want %v", got, tt.wantHeadways)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package mta
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// ErrUnscheduledTrip is returned when a realtime trip has no counterpart in
+// the static schedule, such as trips added by NYCT dispatchers.
+var ErrUnscheduledTrip = errors.New("trip is not in the static schedule")
+
+// TripMatcher joins NYCT realtime trips to the static trips they run.
+//
+// NYCT realtime trip IDs are abbreviated versions of the static ones: the
+// realtime "036050_1..S03R" runs the static
+// "AFA19GEN-1037-Sunday-00_036050_1..S03R" on Sundays. The leading number is
+// the origin time in hundredths of a minute past midnight, followed by the
+// route padded with dots, the direction and an optional path code.
+type TripMatcher struct {
+	feed *static.Feed
+
+	// "036050_1..S" => static trips sorted by ID
+	byKey map[string][]static.Trip
+}
+
+// NewTripMatcher will index the trips of the static feed for matching.
+func NewTripMatcher(feed *static.Feed) *TripMatcher {
+	m := &TripMatcher{feed: feed, byKey: map[string][]static.Trip{}}
+	for _, trip := range feed.Trips {
+		idx := strings.Index(trip.ID, "_")
+		if idx < 0 {
+			continue
+		}
+		id, ok := parseNYCTTripID(trip.ID[idx+1:])
+		if !ok {
+			continue
+		}
+		m.byKey[id.key()] = append(m.byKey[id.key()], trip)
+	}
+	for _, trips := range m.byKey {
+		sort.Slice(trips, func(i, j int) bool {
+			return trips[i].ID < trips[j].ID
+		})
+	}
+	return m
+}
+
+// Match will return the static trip for the given realtime trip along with
+// the service date it runs on. The trip's start_date is used as its service
+// date when present, otherwise serviceDate is. Trips that cannot be found,
+// or that the feed marks as added, return ErrUnscheduledTrip.
+func (m *TripMatcher) Match(td *transit_realtime.TripDescriptor, serviceDate time.Time) (static.Trip, time.Time, error) {
+	if td.GetScheduleRelationship() == transit_realtime.TripDescriptor_ADDED {
+		return static.Trip{}, time.Time{}, ErrUnscheduledTrip
+	}
+	if td.GetStartDate() != "" {
+		d, err := time.ParseInLocation(static.DateFormat, td.GetStartDate(), m.location())
+		if err != nil {
+			return static.Trip{}, time.Time{}, fmt.Errorf("%w: invalid trip start date", err)
+		}
+		serviceDate = d
+	}
+	serviceDate = m.feed.ServiceDate(serviceDate)
+
+	id, ok := parseNYCTTripID(td.GetTripId())
+	if !ok {
+		return static.Trip{}, time.Time{}, fmt.Errorf("%w: unrecognized trip ID %q", ErrUnscheduledTrip, td.GetTripId())
+	}
+
+	if trip, ok := m.match(id, serviceDate); ok {
+		return trip, serviceDate, nil
+	}
+
+	// trips leaving after midnight may belong to the previous service day,
+	// where the schedule lists their origin time past 24:00
+	prev := serviceDate.AddDate(0, 0, -1)
+	id.origin += 24 * 60 * 100
+	if trip, ok := m.match(id, prev); ok {
+		return trip, prev, nil
+	}
+	return static.Trip{}, time.Time{}, ErrUnscheduledTrip
+}
+
+func (m *TripMatcher) match(id nyctTripID, serviceDate time.Time) (static.Trip, bool) {
+	var fallback *static.Trip
+	for _, trip := range m.byKey[id.key()] {
+		if !m.feed.RunsOn(trip.ServiceID, serviceDate) {
+			continue
+		}
+		if strings.HasSuffix(trip.ID, "_"+id.String()) {
+			return trip, true
+		}
+		// realtime trips often leave off the path code and rerouted
+		// trips may carry a different one
+		if fallback == nil {
+			trip := trip
+			fallback = &trip
+		}
+	}
+	if fallback == nil {
+		return static.Trip{}, false
+	}
+	return *fallback, true
+}
+
+func (m *TripMatcher) location() *time.Location {
+	if m.feed.Location == nil {
+		return time.UTC
+	}
+	return m.feed.Location
+}
+
+// nyctTripID is the parsed form of "036050_1..S03R".
+type nyctTripID struct {
+	// hundredths of a minute past midnight
+	origin int
+	// route padded with dots to three characters, like "1.." or "GS."
+	route     string
+	direction string
+	path      string
+}
+
+func parseNYCTTripID(s string) (nyctTripID, bool) {
+	parts := strings.SplitN(s, "_", 2)
+	if len(parts) != 2 || len(parts[1]) < 4 {
+		return nyctTripID{}, false
+	}
+	origin, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nyctTripID{}, false
+	}
+	rest := parts[1]
+	return nyctTripID{
+		origin:    origin,
+		route:     rest[:3],
+		direction: rest[3:4],
+		path:      rest[4:],
+	}, true
+}
+
+func (id nyctTripID) key() string {
+	return fmt.Sprintf("%06d_%s%s", id.origin, id.route, id.direction)
+}
+
+func (id nyctTripID) String() string {
+	return id.key() + id.path
+}
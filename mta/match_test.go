@@ -0,0 +1,158 @@
+package mta
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func TestTripMatcherMatch(t *testing.T) {
+	m := testMatcher(t)
+	tuesday := time.Date(2020, 2, 18, 10, 0, 0, 0, m.location())
+
+	tests := []struct {
+		name      string
+		tripID    string
+		startDate string
+		added     bool
+
+		wantTrip string
+		wantDate string
+		wantErr  error
+		// the error is not expected to wrap ErrUnscheduledTrip
+		wantOtherErr bool
+	}{
+		{
+			name:     "exact",
+			tripID:   "048000_1..S03R",
+			wantTrip: "AFA19GEN-1037-Weekday-00_048000_1..S03R",
+			wantDate: "20200218",
+		},
+		{
+			name:     "exact short run",
+			tripID:   "049000_1..S01R",
+			wantTrip: "AFA19GEN-1037-Weekday-00_049000_1..S01R",
+			wantDate: "20200218",
+		},
+		{
+			name:     "exact over a sibling path",
+			tripID:   "049000_1..S03R",
+			wantTrip: "AFA19GEN-1037-Weekday-00_049000_1..S03R",
+			wantDate: "20200218",
+		},
+		{
+			name:     "no path",
+			tripID:   "049000_1..S",
+			wantTrip: "AFA19GEN-1037-Weekday-00_049000_1..S01R",
+			wantDate: "20200218",
+		},
+		{
+			name:     "unknown path",
+			tripID:   "048000_1..S02R",
+			wantTrip: "AFA19GEN-1037-Weekday-00_048000_1..S03R",
+			wantDate: "20200218",
+		},
+		{
+			name:      "start date",
+			tripID:    "054000_1..S03R",
+			startDate: "20200222",
+			wantTrip:  "AFA19GEN-1037-Saturday-00_054000_1..S03R",
+			wantDate:  "20200222",
+		},
+		{
+			name:      "holiday",
+			tripID:    "054000_1..S03R",
+			startDate: "20200217",
+			wantTrip:  "AFA19GEN-1037-Sunday-00_054000_1..S03R",
+			wantDate:  "20200217",
+		},
+		{
+			name:      "not running",
+			tripID:    "048000_1..S03R",
+			startDate: "20200217",
+			wantErr:   ErrUnscheduledTrip,
+		},
+		{
+			name:      "after midnight",
+			tripID:    "003000_1..S03R",
+			startDate: "20200219",
+			wantTrip:  "AFA19GEN-1037-Weekday-00_147000_1..S03R",
+			wantDate:  "20200218",
+		},
+		{
+			name:      "after midnight listed",
+			tripID:    "147000_1..S03R",
+			startDate: "20200218",
+			wantTrip:  "AFA19GEN-1037-Weekday-00_147000_1..S03R",
+			wantDate:  "20200218",
+		},
+		{
+			name:      "after midnight not running",
+			tripID:    "003000_1..S03R",
+			startDate: "20200223",
+			wantErr:   ErrUnscheduledTrip,
+		},
+		{
+			name:    "added",
+			tripID:  "048000_1..S03R",
+			added:   true,
+			wantErr: ErrUnscheduledTrip,
+		},
+		{
+			name:    "unrecognized",
+			tripID:  "1..S03R",
+			wantErr: ErrUnscheduledTrip,
+		},
+		{
+			name:         "invalid start date",
+			tripID:       "048000_1..S03R",
+			startDate:    "2020-02-18",
+			wantOtherErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := &transit_realtime.TripDescriptor{
+				TripId:  proto.String(tt.tripID),
+				RouteId: proto.String("1"),
+			}
+			if tt.startDate != "" {
+				td.StartDate = proto.String(tt.startDate)
+			}
+			if tt.added {
+				td.ScheduleRelationship = transit_realtime.TripDescriptor_ADDED.Enum()
+			}
+
+			trip, date, err := m.Match(td, tuesday)
+			switch {
+			case tt.wantOtherErr:
+				if err == nil || errors.Is(err, ErrUnscheduledTrip) {
+					t.Fatalf("Match error = %v, want a non-schedule error", err)
+				}
+				return
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Match error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case err != nil:
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if trip.ID != tt.wantTrip {
+				t.Errorf("Match trip = %q, want %q", trip.ID, tt.wantTrip)
+			}
+			if got := date.Format(static.DateFormat); got != tt.wantDate {
+				t.Errorf("Match service date = %s, want %s", got, tt.wantDate)
+			}
+			if date.Location() != m.location() || date.Hour() != 0 {
+				t.Errorf("Match service date = %s, want midnight in %s", date, m.location())
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package mta
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// NyctFeedHeader returns the NYCT extension of a feed header or nil if the
+// header does not carry one.
+func NyctFeedHeader(h *transit_realtime.FeedHeader) *transit_realtime.NyctFeedHeader {
+	ext, _ := getExtension(h, transit_realtime.E_NyctFeedHeader).(*transit_realtime.NyctFeedHeader)
+	return ext
+}
+
+// NyctTripDescriptor returns the NYCT extension of a trip descriptor or nil
+// if the descriptor does not carry one.
+func NyctTripDescriptor(td *transit_realtime.TripDescriptor) *transit_realtime.NyctTripDescriptor {
+	ext, _ := getExtension(td, transit_realtime.E_NyctTripDescriptor).(*transit_realtime.NyctTripDescriptor)
+	return ext
+}
+
+// NyctStopTimeUpdate returns the NYCT extension of a stop time update or nil
+// if the update does not carry one.
+func NyctStopTimeUpdate(upd *transit_realtime.TripUpdate_StopTimeUpdate) *transit_realtime.NyctStopTimeUpdate {
+	ext, _ := getExtension(upd, transit_realtime.E_NyctStopTimeUpdate).(*transit_realtime.NyctStopTimeUpdate)
+	return ext
+}
+
+func getExtension(m proto.Message, xt *proto.ExtensionDesc) interface{} {
+	if m == nil || !proto.HasExtension(m, xt) {
+		return nil
+	}
+	ext, err := proto.GetExtension(m, xt)
+	if err != nil {
+		return nil
+	}
+	return ext
+}
@@ -0,0 +1,179 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// minRefresh is the shortest wait between two fetches of the same feed, so a
+// stale feed timestamp cannot send a poller into a tight loop.
+const minRefresh = 5 * time.Second
+
+// FeedUpdate is sent to subscribers each time a feed changes or fails to
+// refresh. A stale feed is sent with both Feed and Err set.
+type FeedUpdate struct {
+	Type FeedType
+	Feed *transit_realtime.FeedMessage
+	Err  error
+}
+
+// Poller keeps the latest message of a set of subway feeds fresh and
+// notifies subscribers when they change.
+type Poller struct {
+	hc       *http.Client
+	key      string
+	interval time.Duration
+	types    []FeedType
+
+	// fetch is swappable for tests
+	fetch func(context.Context, FeedType) (*transit_realtime.FeedMessage, error)
+
+	mu     sync.RWMutex
+	latest map[FeedType]*transit_realtime.FeedMessage
+	subs   map[chan FeedUpdate]bool
+}
+
+// NewPoller returns a Poller that will fetch each of the given feeds once per
+// interval. The NYCT feeds are regenerated every 30 seconds.
+func NewPoller(hc *http.Client, key string, interval time.Duration, types ...FeedType) *Poller {
+	p := &Poller{
+		hc:       hc,
+		key:      key,
+		interval: interval,
+		types:    types,
+		latest:   map[FeedType]*transit_realtime.FeedMessage{},
+		subs:     map[chan FeedUpdate]bool{},
+	}
+	p.fetch = func(ctx context.Context, ft FeedType) (*transit_realtime.FeedMessage, error) {
+		return GetNYCSubwayFeed(ctx, p.hc, p.key, ft)
+	}
+	return p
+}
+
+// Run will poll every feed until the context is cancelled, then close all
+// subscriber channels and return the context's error.
+func (p *Poller) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, ft := range p.types {
+		wg.Add(1)
+		go func(ft FeedType) {
+			defer wg.Done()
+			p.poll(ctx, ft)
+		}(ft)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	for sub := range p.subs {
+		close(sub)
+		delete(p.subs, sub)
+	}
+	p.mu.Unlock()
+	return ctx.Err()
+}
+
+// Latest returns the most recent message for a feed or nil if it has not
+// been fetched yet.
+func (p *Poller) Latest(ft FeedType) *transit_realtime.FeedMessage {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.latest[ft]
+}
+
+// Subscribe returns a channel of feed updates and a func to cancel the
+// subscription. Updates are dropped rather than blocking the poller when the
+// channel's buffer is full.
+func (p *Poller) Subscribe(buffer int) (<-chan FeedUpdate, func()) {
+	sub := make(chan FeedUpdate, buffer)
+	p.mu.Lock()
+	p.subs[sub] = true
+	p.mu.Unlock()
+
+	var once sync.Once
+	return sub, func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			if p.subs[sub] {
+				close(sub)
+				delete(p.subs, sub)
+			}
+		})
+	}
+}
+
+func (p *Poller) poll(ctx context.Context, ft FeedType) {
+	for {
+		next := p.refresh(ctx, ft)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// refresh fetches a feed, publishes it if it changed and returns when the
+// feed should be fetched next. Stale feeds are still kept and published,
+// along with their error.
+func (p *Poller) refresh(ctx context.Context, ft FeedType) time.Time {
+	now := time.Now()
+	earliest := now.Add(minRefresh)
+
+	feed, err := p.fetch(ctx, ft)
+	if feed == nil {
+		if ctx.Err() == nil {
+			p.publish(FeedUpdate{Type: ft, Err: err})
+		}
+		var ferr *FeedError
+		if errors.As(err, &ferr) && ferr.RetryAfter > p.interval {
+			return now.Add(ferr.RetryAfter)
+		}
+		return now.Add(p.interval)
+	}
+
+	p.mu.Lock()
+	prev := p.latest[ft]
+	changed := prev == nil || feed.GetHeader().GetTimestamp() == 0 ||
+		feed.GetHeader().GetTimestamp() != prev.GetHeader().GetTimestamp()
+	if changed {
+		p.latest[ft] = feed
+	}
+	p.mu.Unlock()
+
+	if changed || err != nil {
+		p.publish(FeedUpdate{Type: ft, Feed: feed, Err: err})
+	}
+
+	// aim for the moment the next version of the feed should be out, unless
+	// that has passed already because the feed is stale or stuck
+	next := now.Add(p.interval)
+	if ts := feed.GetHeader().GetTimestamp(); ts != 0 {
+		if due := time.Unix(int64(ts), 0).Add(p.interval); !due.Before(now) {
+			next = due
+		}
+	}
+	if next.Before(earliest) {
+		next = earliest
+	}
+	return next
+}
+
+func (p *Poller) publish(upd FeedUpdate) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for sub := range p.subs {
+		select {
+		case sub <- upd:
+		default:
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testFeedMessage(ts time.Time) *transit_realtime.FeedMessage {
+	return &transit_realtime.FeedMessage{
+		Header: &transit_realtime.FeedHeader{
+			GtfsRealtimeVersion: proto.String("1.0"),
+			Timestamp:           proto.Uint64(uint64(ts.Unix())),
+		},
+	}
+}
+
+func TestPollerRefresh(t *testing.T) {
+	const interval = 30 * time.Second
+	now := time.Now()
+	stale := &FeedError{Err: ErrStaleFeed}
+
+	tests := []struct {
+		name string
+		// fetched is what each fetch returns in turn
+		fetched []*transit_realtime.FeedMessage
+		errs    []error
+
+		wantUpdates int
+		wantErr     error
+		wantFeed    bool
+		// wantNext is how long after the last fetch the next one is due
+		wantNext time.Duration
+	}{
+		{
+			name:        "fresh",
+			fetched:     []*transit_realtime.FeedMessage{testFeedMessage(now.Add(-10 * time.Second))},
+			errs:        []error{nil},
+			wantUpdates: 1,
+			wantFeed:    true,
+			wantNext:    20 * time.Second,
+		},
+		{
+			name: "unchanged",
+			fetched: []*transit_realtime.FeedMessage{
+				testFeedMessage(now.Add(-10 * time.Second)),
+				testFeedMessage(now.Add(-10 * time.Second)),
+			},
+			errs:        []error{nil, nil},
+			wantUpdates: 1,
+			wantFeed:    true,
+			wantNext:    20 * time.Second,
+		},
+		{
+			name:        "stale",
+			fetched:     []*transit_realtime.FeedMessage{testFeedMessage(now.Add(-time.Hour))},
+			errs:        []error{stale},
+			wantUpdates: 1,
+			wantErr:     ErrStaleFeed,
+			wantFeed:    true,
+			wantNext:    interval,
+		},
+		{
+			name:        "stuck",
+			fetched:     []*transit_realtime.FeedMessage{testFeedMessage(now.Add(-2 * time.Minute))},
+			errs:        []error{nil},
+			wantUpdates: 1,
+			wantFeed:    true,
+			wantNext:    interval,
+		},
+		{
+			name:        "failed",
+			fetched:     []*transit_realtime.FeedMessage{nil},
+			errs:        []error{&FeedError{Err: ErrUpstream}},
+			wantUpdates: 1,
+			wantErr:     ErrUpstream,
+			wantNext:    interval,
+		},
+		{
+			name:    "rate limited",
+			fetched: []*transit_realtime.FeedMessage{nil},
+			errs: []error{&FeedError{Err: ErrRateLimited,
+				RetryAfter: 2 * time.Minute}},
+			wantUpdates: 1,
+			wantErr:     ErrRateLimited,
+			wantNext:    2 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPoller(nil, "", interval, NumberedFeed)
+			calls := 0
+			p.fetch = func(context.Context, FeedType) (*transit_realtime.FeedMessage, error) {
+				f, err := tt.fetched[calls], tt.errs[calls]
+				calls++
+				return f, err
+			}
+			sub, cancel := p.Subscribe(len(tt.fetched))
+			defer cancel()
+
+			var next time.Time
+			var fetched time.Time
+			for range tt.fetched {
+				fetched = time.Now()
+				next = p.refresh(context.Background(), NumberedFeed)
+			}
+
+			var updates []FeedUpdate
+			for len(sub) > 0 {
+				updates = append(updates, <-sub)
+			}
+			if len(updates) != tt.wantUpdates {
+				t.Fatalf("got %d updates, want %d", len(updates), tt.wantUpdates)
+			}
+			last := updates[len(updates)-1]
+			if !errors.Is(last.Err, tt.wantErr) || (tt.wantErr == nil && last.Err != nil) {
+				t.Errorf("update error = %v, want %v", last.Err, tt.wantErr)
+			}
+			if (last.Feed != nil) != tt.wantFeed {
+				t.Errorf("update feed = %v, want a feed: %t", last.Feed, tt.wantFeed)
+			}
+			if tt.wantFeed && p.Latest(NumberedFeed) == nil {
+				t.Error("latest feed was not kept")
+			}
+
+			got := next.Sub(fetched)
+			if diff := got - tt.wantNext; diff < -time.Second || diff > time.Second {
+				t.Errorf("next fetch in %s, want %s", got, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package mta
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// Prediction is a realtime estimate of when a train will be at a stop.
+type Prediction struct {
+	StopID string
+	Time   time.Time
+	// Arrival is true when Time is the predicted arrival rather than the
+	// predicted departure.
+	Arrival bool
+
+	// Delay is the feed's reported lateness against the schedule, if any.
+	Delay time.Duration
+	// Uncertainty is the expected error of Time. Zero means the prediction is
+	// certain; see HasUncertainty for whether the feed reported one at all.
+	Uncertainty    time.Duration
+	HasUncertainty bool
+
+	Update *transit_realtime.TripUpdate_StopTimeUpdate
+}
+
+// PredictionOptions configure Predictions. The zero value returns every
+// future departure (or arrival, for updates without one).
+type PredictionOptions struct {
+	// Now returns the current time, defaulting to time.Now.
+	Now func() time.Time
+	// Limit caps the number of predictions returned. Zero means no limit.
+	Limit int
+	// PreferArrival uses predicted arrivals over departures when an update
+	// has both.
+	PreferArrival bool
+	// MinLead drops trains due sooner than this, such as ones a rider could
+	// not make it to the platform for.
+	MinLead time.Duration
+	// MaxUncertainty drops predictions whose reported uncertainty is larger.
+	// Zero keeps every prediction.
+	MaxUncertainty time.Duration
+}
+
+// Predictions will extract upcoming train times from the given updates,
+// ordered soonest first.
+func Predictions(updates []*transit_realtime.TripUpdate_StopTimeUpdate, opts PredictionOptions) []Prediction {
+	now := time.Now()
+	if opts.Now != nil {
+		now = opts.Now()
+	}
+	earliest := now.Add(opts.MinLead)
+
+	var out []Prediction
+	for _, upd := range updates {
+		p, ok := predict(upd, opts.PreferArrival)
+		if !ok {
+			continue
+		}
+		if !p.Time.After(earliest) {
+			continue
+		}
+		if opts.MaxUncertainty > 0 && p.Uncertainty > opts.MaxUncertainty {
+			continue
+		}
+		out = append(out, p)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.Before(out[j].Time)
+	})
+	if opts.Limit > 0 && len(out) > opts.Limit {
+		out = out[:opts.Limit]
+	}
+	return out
+}
+
+// FeedPredictions behaves like FeedNextTrainTimes but returns structured
+// predictions using the given options.
+func FeedPredictions(f *transit_realtime.FeedMessage, stopId, line string, opts PredictionOptions) (alerts []*transit_realtime.Alert, northbound, southbound []Prediction) {
+	alerts, north, south := Trains(f, stopId, line)
+	return alerts, Predictions(north, opts), Predictions(south, opts)
+}
+
+// predict picks the event to use for an update. Per the GTFS-realtime spec,
+// an event's time is absolute; its delay is only informational when a time is
+// also given and cannot be resolved without the static schedule otherwise.
+func predict(upd *transit_realtime.TripUpdate_StopTimeUpdate, preferArrival bool) (Prediction, bool) {
+	first, second, arrival := upd.GetDeparture(), upd.GetArrival(), false
+	if preferArrival {
+		first, second, arrival = second, first, true
+	}
+	ev := first
+	if ev.GetTime() == 0 {
+		ev, arrival = second, !arrival
+	}
+	if ev.GetTime() == 0 {
+		return Prediction{}, false
+	}
+	return Prediction{
+		StopID:         upd.GetStopId(),
+		Time:           time.Unix(ev.GetTime(), 0),
+		Arrival:        arrival,
+		Delay:          time.Duration(ev.GetDelay()) * time.Second,
+		Uncertainty:    time.Duration(ev.GetUncertainty()) * time.Second,
+		HasUncertainty: ev != nil && ev.Uncertainty != nil,
+		Update:         upd,
+	}, true
+}
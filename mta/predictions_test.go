@@ -0,0 +1,134 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testStopTimeUpdate(stopID string, arrive, depart time.Time, uncertainty int32) *transit_realtime.TripUpdate_StopTimeUpdate {
+	upd := &transit_realtime.TripUpdate_StopTimeUpdate{StopId: proto.String(stopID)}
+	event := func(t time.Time) *transit_realtime.TripUpdate_StopTimeEvent {
+		ev := &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(t.Unix())}
+		if uncertainty > 0 {
+			ev.Uncertainty = proto.Int32(uncertainty)
+		}
+		return ev
+	}
+	if !arrive.IsZero() {
+		upd.Arrival = event(arrive)
+	}
+	if !depart.IsZero() {
+		upd.Departure = event(depart)
+	}
+	return upd
+}
+
+func TestPredictions(t *testing.T) {
+	now := time.Date(2020, 2, 18, 8, 0, 0, 0, time.UTC)
+	min := func(m int) time.Time {
+		return now.Add(time.Duration(m) * time.Minute)
+	}
+	updates := []*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("a", min(4), min(5), 0),
+		testStopTimeUpdate("b", min(-2), min(-1), 0),
+		// terminal: arrival only
+		testStopTimeUpdate("c", min(2), time.Time{}, 0),
+		testStopTimeUpdate("d", min(9), min(10), 120),
+		testStopTimeUpdate("e", time.Time{}, min(1), 30),
+		testStopTimeUpdate("f", time.Time{}, time.Time{}, 0),
+	}
+
+	tests := []struct {
+		name        string
+		opts        PredictionOptions
+		want        []string
+		wantArrival []bool
+		wantTimes   []time.Time
+	}{
+		{
+			name:        "every future train",
+			opts:        PredictionOptions{},
+			want:        []string{"e", "c", "a", "d"},
+			wantArrival: []bool{false, true, false, false},
+			wantTimes:   []time.Time{min(1), min(2), min(5), min(10)},
+		},
+		{
+			name: "limit",
+			opts: PredictionOptions{Limit: 2},
+			want: []string{"e", "c"},
+		},
+		{
+			name:        "prefer arrival",
+			opts:        PredictionOptions{PreferArrival: true},
+			want:        []string{"e", "c", "a", "d"},
+			wantArrival: []bool{false, true, true, true},
+			wantTimes:   []time.Time{min(1), min(2), min(4), min(9)},
+		},
+		{
+			name: "min lead",
+			opts: PredictionOptions{MinLead: 3 * time.Minute},
+			want: []string{"a", "d"},
+		},
+		{
+			name: "max uncertainty",
+			opts: PredictionOptions{MaxUncertainty: time.Minute},
+			want: []string{"e", "c", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Now = func() time.Time { return now }
+
+			var got []string
+			var arrivals []bool
+			var times []time.Time
+			for _, p := range Predictions(updates, opts) {
+				got = append(got, p.StopID)
+				arrivals = append(arrivals, p.Arrival)
+				times = append(times, p.Time)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got stops %v, want %v", got, tt.want)
+			}
+			if tt.wantArrival != nil && !reflect.DeepEqual(arrivals, tt.wantArrival) {
+				t.Errorf("got arrivals %v, want %v", arrivals, tt.wantArrival)
+			}
+			if tt.wantTimes != nil {
+				for i := range times {
+					if !times[i].Equal(tt.wantTimes[i]) {
+						t.Errorf("prediction %d at %s, want %s", i, times[i], tt.wantTimes[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNextTrainTimes(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	var updates []*transit_realtime.TripUpdate_StopTimeUpdate
+	for m := 7; m >= 1; m-- {
+		at := now.Add(time.Duration(m) * time.Minute)
+		updates = append(updates, testStopTimeUpdate("127S", at, at, 0))
+	}
+	// arriving at its last stop, so not a departure
+	updates = append(updates, testStopTimeUpdate("127S", now.Add(30*time.Second), time.Time{}, 0))
+	// already gone
+	updates = append(updates, testStopTimeUpdate("127S", now.Add(-time.Minute), now.Add(-time.Minute), 0))
+
+	got := NextTrainTimes(updates)
+	if len(got) != 5 {
+		t.Fatalf("got %d times, want 5", len(got))
+	}
+	for i, tm := range got {
+		if want := now.Add(time.Duration(i+1) * time.Minute); !tm.Equal(want) {
+			t.Errorf("time %d = %s, want %s", i, tm, want)
+		}
+	}
+}
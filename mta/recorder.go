@@ -0,0 +1,323 @@
+package mta
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+// Observation is a train seen stopping at a platform. Its times are the last
+// prediction the feed made before dropping the stop, which NYCT updates
+// until the train leaves.
+type Observation struct {
+	// TripID is the realtime trip ID and StaticTripID the scheduled trip it
+	// ran, if it was matched.
+	TripID       string
+	StaticTripID string
+	RouteID      string
+	// StopID is the platform, like "127N".
+	StopID string
+	// Direction is "N" or "S".
+	Direction   string
+	ServiceDate time.Time
+
+	// Arrival and Departure are zero when the feed did not predict them,
+	// such as at a trip's origin or terminal.
+	Arrival   time.Time
+	Departure time.Time
+	// PredictedAt is when the last prediction for the stop was seen.
+	PredictedAt time.Time
+
+	// ScheduledArrival and ScheduledDeparture are zero for unscheduled trips.
+	ScheduledArrival   time.Time
+	ScheduledDeparture time.Time
+	// Delay is how late the train left compared to the schedule, or arrived
+	// when there is no departure. It is negative for early trains.
+	Delay time.Duration
+}
+
+// Time returns the observed departure, or the arrival if the train did not
+// depart.
+func (o Observation) Time() time.Time {
+	if !o.Departure.IsZero() {
+		return o.Departure
+	}
+	return o.Arrival
+}
+
+// Scheduled returns the scheduled departure, or the arrival if the stop has
+// no departure.
+func (o Observation) Scheduled() time.Time {
+	if !o.ScheduledDeparture.IsZero() {
+		return o.ScheduledDeparture
+	}
+	return o.ScheduledArrival
+}
+
+// Recorder turns successive snapshots into observed arrivals and departures.
+// A train is observed at a stop when the stop drops out of its trip update.
+// It is safe for concurrent use.
+type Recorder struct {
+	m *TripMatcher
+
+	mu   sync.Mutex
+	deps departures
+	w    *csv.Writer
+	// header written to w
+	header bool
+}
+
+// NewRecorder will create a recorder. The matcher is optional: when given,
+// observations are joined to the static schedule. When w is not nil, every
+// observation is also logged to it as CSV in the format read by
+// ReadObservations.
+func NewRecorder(m *TripMatcher, w io.Writer) *Recorder {
+	r := &Recorder{m: m, deps: newDepartures()}
+	if w != nil {
+		r.w = csv.NewWriter(w)
+	}
+	return r
+}
+
+// Record will compare a snapshot with the last one and return the stops
+// trains have left since, ordered by time.
+func (r *Recorder) Record(snap *Snapshot, now time.Time) ([]Observation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []Observation
+	for _, dep := range r.deps.update(snap, now) {
+		out = append(out, r.observe(dep))
+	}
+	if r.w == nil || len(out) == 0 {
+		return out, nil
+	}
+
+	if !r.header {
+		if err := r.w.Write(observationColumns); err != nil {
+			return out, fmt.Errorf("%w: unable to write observation log", err)
+		}
+		r.header = true
+	}
+	if err := writeObservations(r.w, out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (r *Recorder) observe(dep departure) Observation {
+	upd := dep.update
+	obs := Observation{
+		TripID:      dep.tripID,
+		RouteID:     dep.trip.GetTrip().GetRouteId(),
+		StopID:      upd.GetStopId(),
+		PredictedAt: dep.seen,
+	}
+	if n := len(obs.StopID); n > 1 && (obs.StopID[n-1] == 'N' || obs.StopID[n-1] == 'S') {
+		obs.Direction = obs.StopID[n-1:]
+	}
+	if t := upd.GetArrival().GetTime(); t != 0 {
+		obs.Arrival = time.Unix(t, 0)
+	}
+	if t := upd.GetDeparture().GetTime(); t != 0 {
+		obs.Departure = time.Unix(t, 0)
+	}
+	if r.m == nil {
+		return obs
+	}
+
+	loc := r.m.location()
+	obs.Arrival, obs.Departure, obs.PredictedAt = inLoc(obs.Arrival, loc), inLoc(obs.Departure, loc), inLoc(obs.PredictedAt, loc)
+	trip, date, err := r.m.Match(dep.trip.GetTrip(), obs.Time())
+	if err != nil {
+		return obs
+	}
+	obs.StaticTripID = trip.ID
+	obs.ServiceDate = date
+	for _, st := range r.m.feed.StopTimes[trip.ID] {
+		if st.StopID != obs.StopID {
+			continue
+		}
+		if st.ArrivalTime != static.NoTime {
+			obs.ScheduledArrival = st.ArrivalTime.On(date)
+		}
+		if st.DepartureTime != static.NoTime {
+			obs.ScheduledDeparture = st.DepartureTime.On(date)
+		}
+		break
+	}
+	if !obs.Time().IsZero() && !obs.Scheduled().IsZero() {
+		obs.Delay = obs.Time().Sub(obs.Scheduled())
+	}
+	return obs
+}
+
+func inLoc(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(loc)
+}
+
+var observationColumns = []string{
+	"service_date",
+	"trip_id",
+	"static_trip_id",
+	"route_id",
+	"stop_id",
+	"direction",
+	"arrival",
+	"departure",
+	"predicted_at",
+	"scheduled_arrival",
+	"scheduled_departure",
+	"delay",
+}
+
+// WriteObservations will write observations to w as CSV with a header row.
+func WriteObservations(w io.Writer, obs []Observation) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(observationColumns); err != nil {
+		return fmt.Errorf("%w: unable to write observations", err)
+	}
+	return writeObservations(cw, obs)
+}
+
+func writeObservations(cw *csv.Writer, obs []Observation) error {
+	for _, o := range obs {
+		var date string
+		if !o.ServiceDate.IsZero() {
+			date = o.ServiceDate.Format(static.DateFormat)
+		}
+		err := cw.Write([]string{
+			date,
+			o.TripID,
+			o.StaticTripID,
+			o.RouteID,
+			o.StopID,
+			o.Direction,
+			formatObservationTime(o.Arrival),
+			formatObservationTime(o.Departure),
+			formatObservationTime(o.PredictedAt),
+			formatObservationTime(o.ScheduledArrival),
+			formatObservationTime(o.ScheduledDeparture),
+			strconv.Itoa(int(o.Delay / time.Second)),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: unable to write observations", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("%w: unable to write observations", err)
+	}
+	return nil
+}
+
+// ReadObservations will read observations written by a Recorder or
+// WriteObservations. Header rows repeated by appending to a log are skipped.
+// Service dates are read as midnight in loc, which should be the agency's
+// timezone. A nil loc means UTC.
+func ReadObservations(rd io.Reader, loc *time.Location) ([]Observation, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	r := csv.NewReader(rd)
+	r.FieldsPerRecord = -1
+
+	var (
+		out  []Observation
+		cols map[string]int
+		line int
+	)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return out, nil
+		}
+		line++
+		if err != nil {
+			return out, fmt.Errorf("%w: unable to read observations", err)
+		}
+		if len(rec) > 0 && rec[0] == observationColumns[0] {
+			cols = map[string]int{}
+			for i, col := range rec {
+				cols[strings.TrimSpace(col)] = i
+			}
+			continue
+		}
+		if cols == nil {
+			return out, fmt.Errorf("line %d: missing observations header", line)
+		}
+
+		o, err := parseObservation(rec, cols, loc)
+		if err != nil {
+			return out, fmt.Errorf("%w: line %d", err, line)
+		}
+		out = append(out, o)
+	}
+}
+
+func parseObservation(rec []string, cols map[string]int, loc *time.Location) (Observation, error) {
+	var fail error
+	str := func(col string) string {
+		if i, ok := cols[col]; ok && i < len(rec) {
+			return rec[i]
+		}
+		return ""
+	}
+	tm := func(col string) time.Time {
+		s := str(col)
+		if s == "" || fail != nil {
+			return time.Time{}
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			fail = fmt.Errorf("%w: invalid %s", err, col)
+		}
+		return t
+	}
+
+	o := Observation{
+		TripID:             str("trip_id"),
+		StaticTripID:       str("static_trip_id"),
+		RouteID:            str("route_id"),
+		StopID:             str("stop_id"),
+		Direction:          str("direction"),
+		Arrival:            tm("arrival"),
+		Departure:          tm("departure"),
+		PredictedAt:        tm("predicted_at"),
+		ScheduledArrival:   tm("scheduled_arrival"),
+		ScheduledDeparture: tm("scheduled_departure"),
+	}
+	if s := str("service_date"); s != "" && fail == nil {
+		// service dates are midnight in the agency's timezone, whatever
+		// offset the row's times were written with
+		d, err := time.ParseInLocation(static.DateFormat, s, loc)
+		if err != nil {
+			fail = fmt.Errorf("%w: invalid service_date", err)
+		}
+		o.ServiceDate = d
+	}
+	if s := str("delay"); s != "" && fail == nil {
+		secs, err := strconv.Atoi(s)
+		if err != nil {
+			fail = fmt.Errorf("%w: invalid delay", err)
+		}
+		o.Delay = time.Duration(secs) * time.Second
+	}
+	return o, fail
+}
+
+func formatObservationTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,126 @@
+package mta
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testTripUpdate(tripID string, stops map[string]time.Time) *transit_realtime.FeedEntity {
+	tu := &transit_realtime.TripUpdate{Trip: testTrip(tripID, "")}
+	for _, stopID := range []string{"101S", "103S", "104S"} {
+		t, ok := stops[stopID]
+		if !ok {
+			continue
+		}
+		tu.StopTimeUpdate = append(tu.StopTimeUpdate, &transit_realtime.TripUpdate_StopTimeUpdate{
+			StopId:    proto.String(stopID),
+			Arrival:   &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(t.Unix())},
+			Departure: &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(t.Unix())},
+		})
+	}
+	return &transit_realtime.FeedEntity{Id: proto.String(tripID), TripUpdate: tu}
+}
+
+func TestRecorder(t *testing.T) {
+	m := testMatcher(t)
+	at := func(hour, min int) time.Time {
+		return time.Date(2020, 2, 18, hour, min, 0, 0, m.location())
+	}
+	snapshot := func(stops map[string]time.Time) *Snapshot {
+		feed := testFeedMessage(time.Now())
+		feed.Entity = append(feed.Entity, testTripUpdate("048000_1..S03R", stops))
+		return NewSnapshot(map[FeedType]*transit_realtime.FeedMessage{NumberedFeed: feed})
+	}
+
+	var log bytes.Buffer
+	r := NewRecorder(m, &log)
+	steps := []struct {
+		now   time.Time
+		stops map[string]time.Time
+		want  map[string]time.Duration
+	}{
+		{at(7, 58), map[string]time.Time{"101S": at(8, 1), "103S": at(8, 6), "104S": at(8, 11)}, nil},
+		{at(8, 2), map[string]time.Time{"103S": at(8, 6), "104S": at(8, 11)},
+			map[string]time.Duration{"101S": time.Minute}},
+		{at(8, 12), map[string]time.Time{},
+			map[string]time.Duration{"103S": time.Minute, "104S": time.Minute}},
+	}
+	var all []Observation
+	for i, step := range steps {
+		obs, err := r.Record(snapshot(step.stops), step.now)
+		if err != nil {
+			t.Fatalf("step %d: %s", i, err)
+		}
+		if len(obs) != len(step.want) {
+			t.Fatalf("step %d: got %d observations, want %d", i, len(obs), len(step.want))
+		}
+		for _, o := range obs {
+			if o.StaticTripID != "AFA19GEN-1037-Weekday-00_048000_1..S03R" {
+				t.Errorf("step %d: %s matched %q", i, o.StopID, o.StaticTripID)
+			}
+			if delay, ok := step.want[o.StopID]; !ok || o.Delay != delay {
+				t.Errorf("step %d: %s delayed %s, want %s", i, o.StopID, o.Delay, delay)
+			}
+		}
+		all = append(all, obs...)
+	}
+
+	read, err := ReadObservations(&log, m.location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(all) {
+		t.Fatalf("read %d observations, want %d", len(read), len(all))
+	}
+	for i, o := range read {
+		want := all[i]
+		if o.StopID != want.StopID || !o.Time().Equal(want.Time()) || !o.Scheduled().Equal(want.Scheduled()) ||
+			!o.ServiceDate.Equal(want.ServiceDate) || o.Delay != want.Delay {
+			t.Errorf("read %+v, want %+v", o, want)
+		}
+	}
+}
+
+func TestReadObservationsServiceDate(t *testing.T) {
+	loc := testMatcher(t).location()
+
+	tests := []struct {
+		name      string
+		scheduled string
+		want      time.Time
+	}{
+		{"local offset", "2020-02-18T08:00:00-05:00",
+			time.Date(2020, 2, 18, 0, 0, 0, 0, loc)},
+		{"utc", "2020-02-18T13:00:00Z",
+			time.Date(2020, 2, 18, 0, 0, 0, 0, loc)},
+		// the day after the clocks change, the row's offset is not the
+		// one midnight was at
+		{"daylight saving", "2020-03-08T08:00:00-04:00",
+			time.Date(2020, 3, 8, 0, 0, 0, 0, loc)},
+		{"unscheduled", "",
+			time.Date(2020, 2, 18, 0, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := tt.want.Format("20060102")
+			csv := strings.Join(observationColumns, ",") + "\n" +
+				date + ",048000_1..S03R,,1,101S,S,,,,," + tt.scheduled + ",0\n"
+			obs, err := ReadObservations(strings.NewReader(csv), loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(obs) != 1 {
+				t.Fatalf("got %d observations, want 1", len(obs))
+			}
+			if got := obs[0].ServiceDate; !got.Equal(tt.want) {
+				t.Errorf("service date = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
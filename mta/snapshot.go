@@ -0,0 +1,169 @@
+package mta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// Snapshot is a merged view of several subway feeds, indexed by trip, route
+// and stop.
+type Snapshot struct {
+	Feeds map[FeedType]*transit_realtime.FeedMessage
+	// Errors holds the feeds that could not be fetched. Stale feeds are
+	// still merged but have their error recorded here as well.
+	Errors map[FeedType]error
+
+	// trip ID => trip update
+	Trips map[string]*transit_realtime.TripUpdate
+	// route ID => trip updates
+	Routes map[string][]*transit_realtime.TripUpdate
+	// stop ID => updates for trains stopping there
+	Stops map[string][]StopUpdate
+	// trip ID => vehicle position
+	Vehicles map[string]*transit_realtime.VehiclePosition
+
+	Alerts []*transit_realtime.Alert
+}
+
+// StopUpdate pairs a stop time update with the trip it belongs to.
+type StopUpdate struct {
+	Trip   *transit_realtime.TripUpdate
+	Update *transit_realtime.TripUpdate_StopTimeUpdate
+}
+
+// GetNYCSubwaySnapshot will fetch the given subway feeds (or all of them if
+// none are given) concurrently and merge them into a Snapshot. At most
+// parallelism feeds are fetched at once and each fetch is limited to
+// timeout; zero values mean no limit. Feeds that fail are reported in
+// Snapshot.Errors and an error is only returned if none could be fetched.
+func GetNYCSubwaySnapshot(ctx context.Context, hc *http.Client, key string, parallelism int, timeout time.Duration, types ...FeedType) (*Snapshot, error) {
+	if len(types) == 0 {
+		types = FeedTypes
+	}
+	if parallelism <= 0 {
+		parallelism = len(types)
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		sem   = make(chan struct{}, parallelism)
+		feeds = map[FeedType]*transit_realtime.FeedMessage{}
+		errs  = map[FeedType]error{}
+	)
+	for _, ft := range types {
+		wg.Add(1)
+		go func(ft FeedType) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs[ft] = ctx.Err()
+				mu.Unlock()
+				return
+			}
+
+			fctx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				fctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			feed, err := GetNYCSubwayFeed(fctx, hc, key, ft)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if feed != nil {
+				feeds[ft] = feed
+			}
+			if err != nil {
+				errs[ft] = err
+			}
+		}(ft)
+	}
+	wg.Wait()
+
+	snap := NewSnapshot(feeds)
+	snap.Errors = errs
+	if len(feeds) == 0 {
+		return snap, errors.New("unable to fetch any subway feeds")
+	}
+	return snap, nil
+}
+
+// NewSnapshot will merge and index already fetched feeds.
+func NewSnapshot(feeds map[FeedType]*transit_realtime.FeedMessage) *Snapshot {
+	snap := &Snapshot{
+		Feeds:    feeds,
+		Errors:   map[FeedType]error{},
+		Trips:    map[string]*transit_realtime.TripUpdate{},
+		Routes:   map[string][]*transit_realtime.TripUpdate{},
+		Stops:    map[string][]StopUpdate{},
+		Vehicles: map[string]*transit_realtime.VehiclePosition{},
+	}
+
+	// merge in a stable order so results do not shuffle between calls
+	for _, ft := range sortedFeedTypes(feeds) {
+		for _, ent := range feeds[ft].GetEntity() {
+			if tu := ent.GetTripUpdate(); tu != nil {
+				snap.Trips[tu.GetTrip().GetTripId()] = tu
+				routeID := tu.GetTrip().GetRouteId()
+				snap.Routes[routeID] = append(snap.Routes[routeID], tu)
+				for _, upd := range tu.GetStopTimeUpdate() {
+					snap.Stops[upd.GetStopId()] = append(snap.Stops[upd.GetStopId()],
+						StopUpdate{Trip: tu, Update: upd})
+				}
+			}
+			if vp := ent.GetVehicle(); vp != nil {
+				snap.Vehicles[vp.GetTrip().GetTripId()] = vp
+			}
+			if alert := ent.GetAlert(); alert != nil {
+				snap.Alerts = append(snap.Alerts, alert)
+			}
+		}
+	}
+	return snap
+}
+
+func sortedFeedTypes(feeds map[FeedType]*transit_realtime.FeedMessage) []FeedType {
+	types := make([]FeedType, 0, len(feeds))
+	for ft := range feeds {
+		types = append(types, ft)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
+
+// Trains behaves like the package level Trains func across every feed in
+// the snapshot, taken in the same order NewSnapshot merges them.
+func (s *Snapshot) Trains(stopId, line string) (alerts []*transit_realtime.Alert, northbound, southbound []*transit_realtime.TripUpdate_StopTimeUpdate) {
+	for _, ft := range sortedFeedTypes(s.Feeds) {
+		a, n, so := Trains(s.Feeds[ft], stopId, line)
+		alerts = append(alerts, a...)
+		northbound = append(northbound, n...)
+		southbound = append(southbound, so...)
+	}
+	return alerts, northbound, southbound
+}
+
+// Snapshot will merge the latest version of every feed the poller has
+// fetched.
+func (p *Poller) Snapshot() *Snapshot {
+	p.mu.RLock()
+	feeds := make(map[FeedType]*transit_realtime.FeedMessage, len(p.latest))
+	for ft, feed := range p.latest {
+		feeds[ft] = feed
+	}
+	p.mu.RUnlock()
+	return NewSnapshot(feeds)
+}
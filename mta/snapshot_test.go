@@ -0,0 +1,46 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func TestSnapshotTrainsOrder(t *testing.T) {
+	feeds := map[FeedType]*transit_realtime.FeedMessage{}
+	for _, ft := range FeedTypes {
+		feed := testFeedMessage(time.Now())
+		feed.Entity = []*transit_realtime.FeedEntity{{
+			Id: proto.String(string(ft)),
+			Alert: &transit_realtime.Alert{
+				HeaderText: &transit_realtime.TranslatedString{
+					Translation: []*transit_realtime.TranslatedString_Translation{
+						{Text: proto.String(string(ft))},
+					},
+				},
+			},
+		}}
+		feeds[ft] = feed
+	}
+	snap := NewSnapshot(feeds)
+
+	var want []string
+	for _, ft := range sortedFeedTypes(feeds) {
+		want = append(want, string(ft))
+	}
+	// map order would shuffle these between calls
+	for i := 0; i < 20; i++ {
+		alerts, _, _ := snap.Trains("127", "1")
+		var got []string
+		for _, a := range alerts {
+			got = append(got, Translation(a.GetHeaderText(), AlertLanguage))
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Trains alerts = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package mta
+
+import (
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// TrackService is the kind of service a track carries.
+type TrackService int
+
+const (
+	TrackUnknown TrackService = iota
+	TrackLocal
+	TrackExpress
+)
+
+func (t TrackService) String() string {
+	switch t {
+	case TrackLocal:
+		return "local"
+	case TrackExpress:
+		return "express"
+	default:
+		return "unknown"
+	}
+}
+
+// ManhattanTrackService interprets the Manhattan track configuration used by
+// NYCT: 1 is southbound local, 2 southbound express, 3 northbound express and
+// 4 northbound local. Other tracks, like the Bronx's bi-directional M track,
+// return TrackUnknown.
+func ManhattanTrackService(track string) TrackService {
+	switch track {
+	case "1", "4":
+		return TrackLocal
+	case "2", "3":
+		return TrackExpress
+	default:
+		return TrackUnknown
+	}
+}
+
+// TrackChange is a stop where a train is running on a different track than
+// it was scheduled to. This is the result of a train being manually rerouted
+// off its scheduled path and predictions for it may be unreliable; the
+// countdown clocks drop such trains from all stations.
+type TrackChange struct {
+	TripID  string
+	RouteID string
+	StopID  string
+
+	ScheduledTrack string
+	ActualTrack    string
+
+	// ScheduledService and ActualService use the Manhattan track
+	// configuration and are only meaningful for Manhattan stops.
+	ScheduledService TrackService
+	ActualService    TrackService
+
+	Trip   *transit_realtime.TripDescriptor
+	Update *transit_realtime.TripUpdate_StopTimeUpdate
+}
+
+// TrackChanges will scan a feed for trains running off their scheduled
+// track. NYCT only knows the actual track shortly before a train reaches a
+// station, so only the first change along each trip is reported.
+func TrackChanges(f *transit_realtime.FeedMessage) []TrackChange {
+	var out []TrackChange
+	for _, ent := range f.GetEntity() {
+		tu := ent.GetTripUpdate()
+		for _, upd := range tu.GetStopTimeUpdate() {
+			sched, actual, changed := trackChange(upd)
+			if !changed {
+				continue
+			}
+			out = append(out, TrackChange{
+				TripID:           tu.GetTrip().GetTripId(),
+				RouteID:          tu.GetTrip().GetRouteId(),
+				StopID:           upd.GetStopId(),
+				ScheduledTrack:   sched,
+				ActualTrack:      actual,
+				ScheduledService: ManhattanTrackService(sched),
+				ActualService:    ManhattanTrackService(actual),
+				Trip:             tu.GetTrip(),
+				Update:           upd,
+			})
+			break
+		}
+	}
+	return out
+}
+
+// TrackChanged reports whether the update's actual track differs from its
+// scheduled track.
+func TrackChanged(upd *transit_realtime.TripUpdate_StopTimeUpdate) bool {
+	_, _, changed := trackChange(upd)
+	return changed
+}
+
+func trackChange(upd *transit_realtime.TripUpdate_StopTimeUpdate) (sched, actual string, changed bool) {
+	nstu := NyctStopTimeUpdate(upd)
+	sched, actual = nstu.GetScheduledTrack(), nstu.GetActualTrack()
+	// the actual track is only set for the next station of a trip
+	return sched, actual, sched != "" && actual != "" && sched != actual
+}
@@ -0,0 +1,75 @@
+package mta
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func testTrackUpdate(t *testing.T, stopID, sched, actual string) *transit_realtime.TripUpdate_StopTimeUpdate {
+	t.Helper()
+	upd := &transit_realtime.TripUpdate_StopTimeUpdate{StopId: proto.String(stopID)}
+	ext := &transit_realtime.NyctStopTimeUpdate{}
+	if sched != "" {
+		ext.ScheduledTrack = proto.String(sched)
+	}
+	if actual != "" {
+		ext.ActualTrack = proto.String(actual)
+	}
+	if err := proto.SetExtension(upd, transit_realtime.E_NyctStopTimeUpdate, ext); err != nil {
+		t.Fatal(err)
+	}
+	return upd
+}
+
+func TestTrackChanges(t *testing.T) {
+	trip := func(id string, upds ...*transit_realtime.TripUpdate_StopTimeUpdate) *transit_realtime.FeedEntity {
+		return &transit_realtime.FeedEntity{
+			Id: proto.String(id),
+			TripUpdate: &transit_realtime.TripUpdate{
+				Trip:           &transit_realtime.TripDescriptor{TripId: proto.String(id), RouteId: proto.String("1")},
+				StopTimeUpdate: upds,
+			},
+		}
+	}
+	feed := testFeedMessage(time.Now())
+	feed.Entity = []*transit_realtime.FeedEntity{
+		trip("on track", testTrackUpdate(t, "127S", "1", "1"), testTrackUpdate(t, "128S", "1", "")),
+		// switched to the express track: only the next station knows
+		trip("switched", testTrackUpdate(t, "127S", "1", "2"), testTrackUpdate(t, "128S", "1", "2")),
+		trip("no extension", &transit_realtime.TripUpdate_StopTimeUpdate{StopId: proto.String("127N")}),
+		trip("bronx", testTrackUpdate(t, "213N", "M", "4")),
+	}
+
+	var got []string
+	for _, c := range TrackChanges(feed) {
+		got = append(got, c.TripID+" "+c.StopID+" "+c.ScheduledTrack+">"+c.ActualTrack+" "+
+			c.ScheduledService.String()+">"+c.ActualService.String())
+	}
+	want := []string{
+		"switched 127S 1>2 local>express",
+		"bronx 213N M>4 unknown>local",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TrackChanges = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		upd  *transit_realtime.TripUpdate_StopTimeUpdate
+		want bool
+	}{
+		{testTrackUpdate(t, "127S", "1", "2"), true},
+		{testTrackUpdate(t, "127S", "3", "3"), false},
+		{testTrackUpdate(t, "127S", "3", ""), false},
+		{&transit_realtime.TripUpdate_StopTimeUpdate{}, false},
+	}
+	for i, tt := range tests {
+		if got := TrackChanged(tt.upd); got != tt.want {
+			t.Errorf("%d: TrackChanged = %t, want %t", i, got, tt.want)
+		}
+	}
+}
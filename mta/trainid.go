@@ -0,0 +1,149 @@
+package mta
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// TripType is the trip type designator leading an NYCT train ID.
+type TripType int
+
+const (
+	TripTypeUnknown TripType = iota
+	// TripScheduled is a scheduled revenue trip.
+	TripScheduled
+	// TripReroute is a trip rerouted off its scheduled path.
+	TripReroute
+	// TripSkipStop is a trip skipping stops it was scheduled to make.
+	TripSkipStop
+	// TripTurn is a trip turned short of its scheduled terminal, also known
+	// as short-lined service.
+	TripTurn
+)
+
+func (t TripType) String() string {
+	switch t {
+	case TripScheduled:
+		return "scheduled"
+	case TripReroute:
+		return "reroute"
+	case TripSkipStop:
+		return "skip stop"
+	case TripTurn:
+		return "turn"
+	default:
+		return "unknown"
+	}
+}
+
+// TrainID is a decoded NYCT train ID, the identifier the ATS office system
+// assigns to each train. "06 0123+ PEL/BBR" is a scheduled 6 train leaving
+// Pelham Bay Park at 01:23:30 for Brooklyn Bridge.
+type TrainID struct {
+	Raw string
+
+	Type TripType
+	// Designator is the raw trip type character.
+	Designator string
+	Line       string
+
+	// OriginTime is the scheduled departure from the origin as an offset
+	// from midnight. Origin times do not change when the trip type does.
+	OriginTime time.Duration
+
+	Origin      string
+	Destination string
+}
+
+// ParseTrainID will decode an NYCT train ID like "06 0123+ PEL/BBR".
+func ParseTrainID(s string) (TrainID, error) {
+	id := TrainID{Raw: s}
+	fields := strings.Fields(s)
+	if len(fields) < 2 || len(fields[0]) < 2 {
+		return id, fmt.Errorf("invalid train ID %q", s)
+	}
+
+	id.Designator = fields[0][:1]
+	id.Line = fields[0][1:]
+	switch id.Designator {
+	case "0":
+		id.Type = TripScheduled
+	case "=":
+		id.Type = TripReroute
+	case "/":
+		id.Type = TripSkipStop
+	case "$":
+		id.Type = TripTurn
+	}
+
+	// a trailing + marks the half minute
+	origin := fields[1]
+	if strings.HasSuffix(origin, "+") {
+		id.OriginTime = 30 * time.Second
+		origin = strings.TrimSuffix(origin, "+")
+	}
+	if len(origin) != 4 {
+		return id, fmt.Errorf("invalid origin time in train ID %q", s)
+	}
+	hours, err := strconv.Atoi(origin[:2])
+	if err != nil || hours < 0 {
+		return id, fmt.Errorf("invalid origin time in train ID %q", s)
+	}
+	mins, err := strconv.Atoi(origin[2:])
+	if err != nil || mins < 0 || mins > 59 {
+		return id, fmt.Errorf("invalid origin time in train ID %q", s)
+	}
+	id.OriginTime += time.Duration(hours)*time.Hour + time.Duration(mins)*time.Minute
+
+	locs := strings.SplitN(strings.Join(fields[2:], ""), "/", 2)
+	id.Origin = locs[0]
+	if len(locs) == 2 {
+		id.Destination = locs[1]
+	}
+	return id, nil
+}
+
+func (id TrainID) String() string {
+	return id.Raw
+}
+
+// Rerouted reports whether the trip no longer follows its base schedule.
+func (id TrainID) Rerouted() bool {
+	return id.Type == TripReroute || id.Type == TripSkipStop || id.Type == TripTurn
+}
+
+// EntityTrainID will return the decoded train ID of the trip a feed entity
+// describes, looking at its trip update and then its vehicle position. The
+// bool is false when the entity has no NYCT train ID.
+func EntityTrainID(ent *transit_realtime.FeedEntity) (TrainID, bool, error) {
+	td := ent.GetTripUpdate().GetTrip()
+	if td == nil {
+		td = ent.GetVehicle().GetTrip()
+	}
+	return TripTrainID(td)
+}
+
+// TripTrainID will return the decoded train ID from a trip descriptor's
+// NYCT extension. The bool is false when the descriptor has no train ID.
+func TripTrainID(td *transit_realtime.TripDescriptor) (TrainID, bool, error) {
+	ntd := NyctTripDescriptor(td)
+	if ntd.GetTrainId() == "" {
+		return TrainID{}, false, nil
+	}
+	id, err := ParseTrainID(ntd.GetTrainId())
+	return id, true, err
+}
+
+// IsAssigned reports whether the entity's trip has been assigned to a
+// physical train, meaning it is underway or will most likely depart shortly.
+func IsAssigned(ent *transit_realtime.FeedEntity) bool {
+	td := ent.GetTripUpdate().GetTrip()
+	if td == nil {
+		td = ent.GetVehicle().GetTrip()
+	}
+	return NyctTripDescriptor(td).GetIsAssigned()
+}
@@ -0,0 +1,130 @@
+package mta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+func TestParseTrainID(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    TrainID
+		wantErr bool
+	}{
+		{
+			in: "06 0123+ PEL/BBR",
+			want: TrainID{Type: TripScheduled, Designator: "0", Line: "6",
+				OriginTime: time.Hour + 23*time.Minute + 30*time.Second, Origin: "PEL", Destination: "BBR"},
+		},
+		{
+			in: "=A 1405 207/FAR",
+			want: TrainID{Type: TripReroute, Designator: "=", Line: "A",
+				OriginTime: 14*time.Hour + 5*time.Minute, Origin: "207", Destination: "FAR"},
+		},
+		{
+			in: "/5X 0800 DYR/BBR",
+			want: TrainID{Type: TripSkipStop, Designator: "/", Line: "5X",
+				OriginTime: 8 * time.Hour, Origin: "DYR", Destination: "BBR"},
+		},
+		{
+			in: "$GS 2359+ TSQ/GCS",
+			want: TrainID{Type: TripTurn, Designator: "$", Line: "GS",
+				OriginTime: 23*time.Hour + 59*time.Minute + 30*time.Second, Origin: "TSQ", Destination: "GCS"},
+		},
+		{
+			// service past midnight keeps counting hours
+			in: "01 2430 242/SFT",
+			want: TrainID{Type: TripScheduled, Designator: "0", Line: "1",
+				OriginTime: 24*time.Hour + 30*time.Minute, Origin: "242", Destination: "SFT"},
+		},
+		{
+			// the locations are sometimes split by spaces
+			in: "0L 0600 8 AV / RPY",
+			want: TrainID{Type: TripScheduled, Designator: "0", Line: "L",
+				OriginTime: 6 * time.Hour, Origin: "8AV", Destination: "RPY"},
+		},
+		{
+			in: "0Q 0915",
+			want: TrainID{Type: TripScheduled, Designator: "0", Line: "Q",
+				OriginTime: 9*time.Hour + 15*time.Minute},
+		},
+		{
+			in: "?7 1000 MST/34H",
+			want: TrainID{Type: TripTypeUnknown, Designator: "?", Line: "7",
+				OriginTime: 10 * time.Hour, Origin: "MST", Destination: "34H"},
+		},
+		{in: "", wantErr: true},
+		{in: "06", wantErr: true},
+		{in: "6 0123 PEL/BBR", wantErr: true},
+		{in: "06 123 PEL/BBR", wantErr: true},
+		{in: "06 01a3 PEL/BBR", wantErr: true},
+		{in: "06 0160 PEL/BBR", wantErr: true},
+		{in: "06 -100 PEL/BBR", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTrainID(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTrainID(%q) error = %v, want error: %t", tt.in, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		tt.want.Raw = tt.in
+		if got != tt.want {
+			t.Errorf("ParseTrainID(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if got.Rerouted() != (tt.want.Type == TripReroute || tt.want.Type == TripSkipStop || tt.want.Type == TripTurn) {
+			t.Errorf("ParseTrainID(%q).Rerouted() = %t", tt.in, got.Rerouted())
+		}
+	}
+}
+
+func TestEntityTrainID(t *testing.T) {
+	withTrainID := func(trainID string, assigned bool) *transit_realtime.TripDescriptor {
+		td := &transit_realtime.TripDescriptor{TripId: proto.String("048000_1..S03R")}
+		err := proto.SetExtension(td, transit_realtime.E_NyctTripDescriptor, &transit_realtime.NyctTripDescriptor{
+			TrainId:    proto.String(trainID),
+			IsAssigned: proto.Bool(assigned),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return td
+	}
+
+	tests := []struct {
+		name         string
+		ent          *transit_realtime.FeedEntity
+		wantOK       bool
+		wantErr      bool
+		wantAssigned bool
+	}{
+		{"trip update", &transit_realtime.FeedEntity{
+			TripUpdate: &transit_realtime.TripUpdate{Trip: withTrainID("01 0800 242/SFT", true)},
+		}, true, false, true},
+		{"vehicle", &transit_realtime.FeedEntity{
+			Vehicle: &transit_realtime.VehiclePosition{Trip: withTrainID("01 0800 242/SFT", false)},
+		}, true, false, false},
+		{"invalid", &transit_realtime.FeedEntity{
+			TripUpdate: &transit_realtime.TripUpdate{Trip: withTrainID("01 8 242/SFT", true)},
+		}, true, true, true},
+		{"no extension", &transit_realtime.FeedEntity{
+			TripUpdate: &transit_realtime.TripUpdate{Trip: &transit_realtime.TripDescriptor{}},
+		}, false, false, false},
+		{"empty", &transit_realtime.FeedEntity{}, false, false, false},
+	}
+	for _, tt := range tests {
+		id, ok, err := EntityTrainID(tt.ent)
+		if ok != tt.wantOK || (err != nil) != tt.wantErr {
+			t.Errorf("%s: EntityTrainID = %+v, %t, %v", tt.name, id, ok, err)
+		}
+		if got := IsAssigned(tt.ent); got != tt.wantAssigned {
+			t.Errorf("%s: IsAssigned = %t, want %t", tt.name, got, tt.wantAssigned)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package mta
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs"
+	"github.com/jprobinson/gtfs/geo"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// TrainPosition is where a train is, or is estimated to be.
+type TrainPosition struct {
+	TripID  string
+	RouteID string
+
+	// Status is the train's status relative to StopID, as reported by its
+	// VehiclePosition or inferred from its predictions.
+	Status transit_realtime.VehiclePosition_VehicleStopStatus
+	StopID string
+
+	// LastStopID is the station the train last stopped at and NextStopID the
+	// one it is heading to. Either may be empty when unknown; a stopped
+	// train has no next stop.
+	LastStopID string
+	NextStopID string
+
+	Lat float64
+	Lon float64
+	// Estimated is true when Lat and Lon were interpolated between stations.
+	Estimated bool
+	// DistanceToNext is how far the train is from NextStopID in meters.
+	DistanceToNext float64
+
+	// Timestamp is when the vehicle reported its position, if it did.
+	Timestamp time.Time
+
+	Vehicle *transit_realtime.VehiclePosition
+	Trip    *transit_realtime.TripUpdate
+}
+
+// Tracker follows trains across snapshots to place them between stations.
+// NYCT vehicles only report the stop they are at or heading to, so the
+// tracker remembers when each train left its last station and interpolates
+// along the line using station coordinates and predicted arrivals. It is
+// safe for concurrent use.
+type Tracker struct {
+	m *TripMatcher
+
+	mu sync.Mutex
+	// trip ID => last station the train was seen at
+	last map[string]stationTime
+	// trip ID => first stop with a prediction in the last update
+	first map[string]stationTime
+}
+
+type stationTime struct {
+	stopID string
+	at     time.Time
+}
+
+// NewTracker will create a tracker. The matcher is optional: when given, the
+// static schedule fills in a train's previous station until the tracker has
+// seen it leave one.
+func NewTracker(m *TripMatcher) *Tracker {
+	return &Tracker{
+		m:     m,
+		last:  map[string]stationTime{},
+		first: map[string]stationTime{},
+	}
+}
+
+// Update will record a snapshot and return the position of every train in
+// it that can be placed, ordered by trip ID.
+func (t *Tracker) Update(snap *Snapshot, now time.Time) []TrainPosition {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	active := map[string]bool{}
+	for id := range snap.Trips {
+		active[id] = true
+	}
+	for id := range snap.Vehicles {
+		active[id] = true
+	}
+	ids := make([]string, 0, len(active))
+	for id := range active {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var out []TrainPosition
+	for _, id := range ids {
+		tu, vp := snap.Trips[id], snap.Vehicles[id]
+		t.observe(id, tu, vp, now)
+		if pos, ok := t.position(id, tu, vp, now); ok {
+			out = append(out, pos)
+		}
+	}
+
+	// forget trains that have left the feed
+	for id := range t.last {
+		if !active[id] {
+			delete(t.last, id)
+		}
+	}
+	for id := range t.first {
+		if !active[id] {
+			delete(t.first, id)
+		}
+	}
+	return out
+}
+
+// observe notes the stations a train has been seen at or has left.
+func (t *Tracker) observe(id string, tu *transit_realtime.TripUpdate, vp *transit_realtime.VehiclePosition, now time.Time) {
+	upds := tu.GetStopTimeUpdate()
+	listed := map[string]bool{}
+	for _, upd := range upds {
+		listed[stationID(upd.GetStopId())] = true
+	}
+
+	// a stop dropping off the front of the predictions has been departed
+	if prev, ok := t.first[id]; ok && !listed[prev.stopID] {
+		t.last[id] = prev
+	}
+	delete(t.first, id)
+	if len(upds) > 0 {
+		if p, ok := predict(upds[0], false); ok {
+			t.first[id] = stationTime{stopID: stationID(upds[0].GetStopId()), at: p.Time}
+		}
+	}
+
+	if vp != nil && vp.GetStopId() != "" &&
+		vp.GetCurrentStatus() == transit_realtime.VehiclePosition_STOPPED_AT {
+		t.last[id] = stationTime{stopID: stationID(vp.GetStopId()), at: now}
+	}
+}
+
+func (t *Tracker) position(id string, tu *transit_realtime.TripUpdate, vp *transit_realtime.VehiclePosition, now time.Time) (TrainPosition, bool) {
+	td := tu.GetTrip()
+	if td == nil {
+		td = vp.GetTrip()
+	}
+	pos := TrainPosition{
+		TripID:  id,
+		RouteID: td.GetRouteId(),
+		Status:  transit_realtime.VehiclePosition_IN_TRANSIT_TO,
+		Vehicle: vp,
+		Trip:    tu,
+	}
+	if vp.GetTimestamp() != 0 {
+		pos.Timestamp = time.Unix(int64(vp.GetTimestamp()), 0)
+	}
+
+	upds := tu.GetStopTimeUpdate()
+	if vp.GetStopId() != "" {
+		pos.StopID = vp.GetStopId()
+		pos.Status = vp.GetCurrentStatus()
+	} else if len(upds) > 0 {
+		pos.StopID = upds[0].GetStopId()
+		if arr := upds[0].GetArrival().GetTime(); arr != 0 && arr <= now.Unix() {
+			pos.Status = transit_realtime.VehiclePosition_STOPPED_AT
+		}
+	} else {
+		return pos, false
+	}
+
+	next := stationID(pos.StopID)
+	nextStop, ok := t.stop(next)
+	if !ok {
+		return pos, false
+	}
+	if pos.Status == transit_realtime.VehiclePosition_STOPPED_AT {
+		pos.LastStopID = next
+		pos.Lat, pos.Lon = nextStop.Lat, nextStop.Lon
+		return pos, true
+	}
+	pos.NextStopID = next
+
+	var arrive time.Time
+	for _, upd := range upds {
+		if stationID(upd.GetStopId()) != next {
+			continue
+		}
+		if p, ok := predict(upd, true); ok {
+			arrive = p.Time
+		}
+		break
+	}
+
+	prev, left := t.previous(id, td, pos.StopID, arrive, now)
+	prevStop, ok := t.stop(prev)
+	if !ok {
+		// nowhere to come from, so put the train at the station it is
+		// heading to
+		pos.Lat, pos.Lon = nextStop.Lat, nextStop.Lon
+		return pos, true
+	}
+	pos.LastStopID = prev
+
+	// without times, incoming trains are at the platform and the rest are
+	// halfway there
+	frac := 0.5
+	if pos.Status == transit_realtime.VehiclePosition_INCOMING_AT {
+		frac = 1
+	}
+	if !left.IsZero() && !arrive.IsZero() && arrive.After(left) {
+		frac = float64(now.Sub(left)) / float64(arrive.Sub(left))
+		if frac < 0 {
+			frac = 0
+		}
+		if frac > 1 {
+			frac = 1
+		}
+	}
+	pos.Lat = prevStop.Lat + frac*(nextStop.Lat-prevStop.Lat)
+	pos.Lon = prevStop.Lon + frac*(nextStop.Lon-prevStop.Lon)
+	pos.Estimated = true
+	pos.DistanceToNext = geo.Distance(pos.Lat, pos.Lon, nextStop.Lat, nextStop.Lon)
+	return pos, true
+}
+
+// previous finds the station a train heading to stopID last left and when,
+// if known: first from what the tracker has seen, then from the static
+// schedule shifted by the train's delay and finally from the station before
+// it on the route's patterns or stops.
+func (t *Tracker) previous(id string, td *transit_realtime.TripDescriptor, stopID string, arrive, now time.Time) (string, time.Time) {
+	next := stationID(stopID)
+	if last, ok := t.last[id]; ok && last.stopID != next {
+		return last.stopID, last.at
+	}
+
+	if t.m != nil {
+		if trip, date, err := t.m.Match(td, now); err == nil {
+			sts := t.m.feed.StopTimes[trip.ID]
+			for i := 1; i < len(sts); i++ {
+				if stationID(sts[i].StopID) != next {
+					continue
+				}
+				var delay time.Duration
+				if !arrive.IsZero() && sts[i].ArrivalTime >= 0 {
+					delay = arrive.Sub(sts[i].ArrivalTime.On(date))
+				}
+				var left time.Time
+				if dep := sts[i-1].DepartureTime; dep >= 0 {
+					left = dep.On(date).Add(delay)
+				}
+				return stationID(sts[i-1].StopID), left
+			}
+		}
+	}
+
+	route, ok := subwayRoute(td.GetRouteId())
+	if !ok {
+		return "", time.Time{}
+	}
+	dir := "S"
+	if strings.HasSuffix(stopID, "N") {
+		dir = "N"
+	}
+	if tid, ok := parseNYCTTripID(td.GetTripId()); ok && next == stopID {
+		dir = tid.direction
+	}
+	// patterns list stations in order of travel and cover branches, so use
+	// the busiest one running this way through the station
+	for _, pattern := range route.Patterns {
+		if pattern.Direction != dir {
+			continue
+		}
+		for i := 1; i < len(pattern.StopIDs); i++ {
+			if pattern.StopIDs[i] == next {
+				return pattern.StopIDs[i-1], time.Time{}
+			}
+		}
+	}
+
+	// route stops run southbound
+	northbound := dir == "N"
+	for i, stop := range route.Stops {
+		if stop.ID != next {
+			continue
+		}
+		if northbound && i+1 < len(route.Stops) {
+			return route.Stops[i+1].ID, time.Time{}
+		}
+		if !northbound && i > 0 {
+			return route.Stops[i-1].ID, time.Time{}
+		}
+		break
+	}
+	return "", time.Time{}
+}
+
+// stop looks up a station's coordinates in NYCSubwayRoutes, then the
+// matcher's static feed.
+func (t *Tracker) stop(stationID string) (gtfs.Stop, bool) {
+	if stationID == "" {
+		return gtfs.Stop{}, false
+	}
+	if stop, ok := stopsByID()[stationID]; ok && (stop.Lat != 0 || stop.Lon != 0) {
+		return stop, true
+	}
+	if t.m != nil {
+		if stop, ok := t.m.feed.Stops[stationID]; ok {
+			return gtfs.Stop{ID: stop.ID, MTAName: stop.Name, Lat: stop.Lat, Lon: stop.Lon}, true
+		}
+	}
+	return gtfs.Stop{}, false
+}
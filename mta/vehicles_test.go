@@ -0,0 +1,297 @@
+package mta
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs"
+	"github.com/jprobinson/gtfs/geo"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+const testTrackerTrip = "048000_1..S03R"
+
+func testTrackerSnapshot(upds []*transit_realtime.TripUpdate_StopTimeUpdate, vp *transit_realtime.VehiclePosition) *Snapshot {
+	td := &transit_realtime.TripDescriptor{
+		TripId:    proto.String(testTrackerTrip),
+		RouteId:   proto.String("1"),
+		StartDate: proto.String("20200218"),
+	}
+	snap := &Snapshot{
+		Trips:    map[string]*transit_realtime.TripUpdate{},
+		Vehicles: map[string]*transit_realtime.VehiclePosition{},
+	}
+	if upds != nil {
+		snap.Trips[testTrackerTrip] = &transit_realtime.TripUpdate{Trip: td, StopTimeUpdate: upds}
+	}
+	if vp != nil {
+		vp.Trip = td
+		snap.Vehicles[testTrackerTrip] = vp
+	}
+	return snap
+}
+
+// between is the point frac of the way from one station to another.
+func between(t *testing.T, from, to string, frac float64) (float64, float64) {
+	t.Helper()
+	a, ok := stopsByID()[from]
+	if !ok {
+		t.Fatalf("unknown station %q", from)
+	}
+	b, ok := stopsByID()[to]
+	if !ok {
+		t.Fatalf("unknown station %q", to)
+	}
+	return a.Lat + frac*(b.Lat-a.Lat), a.Lon + frac*(b.Lon-a.Lon)
+}
+
+func checkPosition(t *testing.T, got []TrainPosition, status transit_realtime.VehiclePosition_VehicleStopStatus, last, next string, lat, lon float64, estimated bool) {
+	t.Helper()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 position, got %d: %+v", len(got), got)
+	}
+	pos := got[0]
+	if pos.TripID != testTrackerTrip || pos.RouteID != "1" {
+		t.Errorf("position for %q on %q, want %q on 1", pos.TripID, pos.RouteID, testTrackerTrip)
+	}
+	if pos.Status != status {
+		t.Errorf("status = %s, want %s", pos.Status, status)
+	}
+	if pos.LastStopID != last || pos.NextStopID != next {
+		t.Errorf("between %q and %q, want %q and %q", pos.LastStopID, pos.NextStopID, last, next)
+	}
+	if math.Abs(pos.Lat-lat) > 1e-9 || math.Abs(pos.Lon-lon) > 1e-9 {
+		t.Errorf("position = %f,%f, want %f,%f", pos.Lat, pos.Lon, lat, lon)
+	}
+	if pos.Estimated != estimated {
+		t.Errorf("estimated = %t, want %t", pos.Estimated, estimated)
+	}
+	if estimated {
+		stop := stopsByID()[next]
+		if want := geo.Distance(lat, lon, stop.Lat, stop.Lon); math.Abs(pos.DistanceToNext-want) > 1e-6 {
+			t.Errorf("distance to next = %f, want %f", pos.DistanceToNext, want)
+		}
+	}
+}
+
+func TestTrackerPredictions(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t0 := time.Date(2020, 2, 18, 8, 0, 0, 0, loc)
+	min := func(m float64) time.Time {
+		return t0.Add(time.Duration(m * float64(time.Minute)))
+	}
+	tr := NewTracker(nil)
+
+	// nothing seen yet: halfway from the station before on the route
+	got := tr.Update(testTrackerSnapshot([]*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("103S", min(1), min(1), 0),
+		testStopTimeUpdate("104S", min(5), min(5), 0),
+	}, nil), t0)
+	lat, lon := between(t, "101", "103", 0.5)
+	checkPosition(t, got, transit_realtime.VehiclePosition_IN_TRANSIT_TO, "101", "103", lat, lon, true)
+
+	// 103 dropped off the predictions, so the train left it at its last
+	// predicted departure and is a quarter of the way to its 104 arrival
+	got = tr.Update(testTrackerSnapshot([]*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("104S", min(5), min(5), 0),
+	}, nil), min(2))
+	lat, lon = between(t, "103", "104", 0.25)
+	checkPosition(t, got, transit_realtime.VehiclePosition_IN_TRANSIT_TO, "103", "104", lat, lon, true)
+
+	// past its predicted arrival the train is at the station
+	got = tr.Update(testTrackerSnapshot([]*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("104S", min(5), min(5.5), 0),
+	}, nil), min(5.25))
+	lat, lon = between(t, "104", "104", 0)
+	checkPosition(t, got, transit_realtime.VehiclePosition_STOPPED_AT, "104", "", lat, lon, false)
+
+	// trains that leave the feed are forgotten
+	if got := tr.Update(testTrackerSnapshot(nil, nil), min(6)); len(got) != 0 {
+		t.Errorf("expected no positions, got %+v", got)
+	}
+	if len(tr.last) != 0 || len(tr.first) != 0 {
+		t.Errorf("expected the trip to be forgotten, still have %v and %v", tr.last, tr.first)
+	}
+}
+
+func TestTrackerVehicles(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t0 := time.Date(2020, 2, 18, 8, 0, 0, 0, loc)
+	min := func(m float64) time.Time {
+		return t0.Add(time.Duration(m * float64(time.Minute)))
+	}
+	tr := NewTracker(nil)
+
+	got := tr.Update(testTrackerSnapshot([]*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("104S", min(3), min(3), 0),
+	}, &transit_realtime.VehiclePosition{
+		StopId:        proto.String("103S"),
+		CurrentStatus: transit_realtime.VehiclePosition_STOPPED_AT.Enum(),
+		Timestamp:     proto.Uint64(uint64(min(-0.5).Unix())),
+	}), t0)
+	lat, lon := between(t, "103", "103", 0)
+	checkPosition(t, got, transit_realtime.VehiclePosition_STOPPED_AT, "103", "", lat, lon, false)
+	if len(got) == 1 {
+		if !got[0].Timestamp.Equal(min(-0.5)) {
+			t.Errorf("timestamp = %s, want %s", got[0].Timestamp, min(-0.5))
+		}
+		if got[0].StopID != "103S" {
+			t.Errorf("stop = %q, want 103S", got[0].StopID)
+		}
+	}
+
+	// the train was last seen stopped at 103, so it left when it was seen
+	got = tr.Update(testTrackerSnapshot([]*transit_realtime.TripUpdate_StopTimeUpdate{
+		testStopTimeUpdate("104S", min(3), min(3), 0),
+	}, &transit_realtime.VehiclePosition{
+		StopId:        proto.String("104S"),
+		CurrentStatus: transit_realtime.VehiclePosition_IN_TRANSIT_TO.Enum(),
+	}), min(2))
+	lat, lon = between(t, "103", "104", 2.0/3)
+	checkPosition(t, got, transit_realtime.VehiclePosition_IN_TRANSIT_TO, "103", "104", lat, lon, true)
+
+	// without an arrival, incoming trains are at the platform
+	got = tr.Update(testTrackerSnapshot(nil, &transit_realtime.VehiclePosition{
+		StopId:        proto.String("104S"),
+		CurrentStatus: transit_realtime.VehiclePosition_INCOMING_AT.Enum(),
+	}), min(2.5))
+	lat, lon = between(t, "103", "104", 1)
+	checkPosition(t, got, transit_realtime.VehiclePosition_INCOMING_AT, "103", "104", lat, lon, true)
+}
+
+func TestTrackerPrevious(t *testing.T) {
+	m := testMatcher(t)
+	at := func(hour, min int) time.Time {
+		return time.Date(2020, 2, 18, hour, min, 0, 0, m.location())
+	}
+
+	// give the 1 patterns that skip 103 so they are told apart from its
+	// stops
+	route := gtfs.NYCSubwayRoutes["1"]
+	patterned := route
+	patterned.Patterns = []gtfs.Pattern{
+		{Direction: "S", StopIDs: []string{"101", "104"}},
+		{Direction: "N", StopIDs: []string{"104", "101"}},
+	}
+	gtfs.NYCSubwayRoutes["1"] = patterned
+	defer func() { gtfs.NYCSubwayRoutes["1"] = route }()
+
+	tests := []struct {
+		name    string
+		matcher *TripMatcher
+		seen    *stationTime
+		tripID  string
+		routeID string
+		stopID  string
+		arrive  time.Time
+
+		want     string
+		wantLeft time.Time
+	}{
+		{
+			name:     "seen",
+			matcher:  m,
+			seen:     &stationTime{stopID: "103", at: at(8, 6)},
+			stopID:   "104S",
+			want:     "103",
+			wantLeft: at(8, 6),
+		},
+		{
+			name:     "schedule",
+			matcher:  m,
+			stopID:   "104S",
+			arrive:   at(8, 12),
+			want:     "103",
+			wantLeft: at(8, 7),
+		},
+		{
+			name:     "schedule without arrival",
+			matcher:  m,
+			stopID:   "104S",
+			want:     "103",
+			wantLeft: at(8, 5),
+		},
+		{
+			name:     "seen at the next station",
+			matcher:  m,
+			seen:     &stationTime{stopID: "104", at: at(8, 6)},
+			stopID:   "104S",
+			want:     "103",
+			wantLeft: at(8, 5),
+		},
+		{
+			name:    "unscheduled",
+			matcher: m,
+			tripID:  "070000_1..S03R",
+			stopID:  "104S",
+			want:    "101",
+		},
+		{
+			name:   "pattern",
+			stopID: "104S",
+			want:   "101",
+		},
+		{
+			name:   "pattern northbound",
+			tripID: "060000_1..N03R",
+			stopID: "101N",
+			want:   "104",
+		},
+		{
+			name:   "stops",
+			stopID: "103S",
+			want:   "101",
+		},
+		{
+			name:   "stops northbound",
+			tripID: "060000_1..N03R",
+			stopID: "103N",
+			want:   "104",
+		},
+		{
+			name:   "terminal",
+			stopID: "101S",
+		},
+		{
+			name:    "unknown route",
+			routeID: "Z",
+			stopID:  "104S",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := NewTracker(tt.matcher)
+			if tt.tripID == "" {
+				tt.tripID = testTrackerTrip
+			}
+			if tt.routeID == "" {
+				tt.routeID = "1"
+			}
+			if tt.seen != nil {
+				tr.last[tt.tripID] = *tt.seen
+			}
+			td := &transit_realtime.TripDescriptor{
+				TripId:    proto.String(tt.tripID),
+				RouteId:   proto.String(tt.routeID),
+				StartDate: proto.String("20200218"),
+			}
+
+			got, left := tr.previous(tt.tripID, td, tt.stopID, tt.arrive, at(8, 9))
+			if got != tt.want {
+				t.Errorf("previous station = %q, want %q", got, tt.want)
+			}
+			if !left.Equal(tt.wantLeft) {
+				t.Errorf("left at %s, want %s", left, tt.wantLeft)
+			}
+		})
+	}
+}
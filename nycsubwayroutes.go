@@ -1,3 +1,3 @@
 package gtfs
 
-var NYCSubwayRoutes = map[string]Route{"1":Route{Name:"1", Northbound:"Bronx", Southbound:"South Ferry", Stops:[]Stop{Stop{ID:"101", MTAName:"Van Cortlandt Park - 242 St", DisplayName:"Van Cortlandt Park - 242nd Street", PhoneticName:"Van Cortlandt Park, 242nd Street", Synonyms:[]string{"Van Cortlandt Park", "242nd Street", "242nd Street, Van Cortlandt Park", "Van Cortlandt Park, 242nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"103", MTAName:"238 St", DisplayName:"238th Street", PhoneticName:"238th Street", Synonyms:[]string{"238th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"104", MTAName:"231 St", DisplayName:"231st Street", PhoneticName:"231st Street", Synonyms:[]string{"231st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"106", MTAName:"Marble Hill - 225 St", DisplayName:"Marble Hill - 225th Street", PhoneticName:"Marble Hill, 225th Street", Synonyms:[]string{"Marble Hill", "225th Street", "225th Street, Marble Hill", "Marble Hill, 225th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"107", MTAName:"215 St", DisplayName:"215th Street", PhoneticName:"215th Street", Synonyms:[]string{"215th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"108", MTAName:"207 St", DisplayName:"207th Street", PhoneticName:"207th Street", Synonyms:[]string{"207th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"109", MTAName:"Dyckman St", DisplayName:"Dyckman Street", PhoneticName:"Dyckman Street", Synonyms:[]string{"Dyckman Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"110", MTAName:"191 St", DisplayName:"191st Street", PhoneticName:"191st Street", Synonyms:[]string{"191st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"111", MTAName:"181 St", DisplayName:"181st Street", PhoneticName:"181st Street", Synonyms:[]string{"181st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"112", MTAName:"168 St - Washington Hts", DisplayName:"168th Street, Washington Heights", PhoneticName:"168th Street, Washington Heights", Synonyms:[]string{"168th Street", "Washington Heights", "Washington Heights, 168th Street"}, Transfers:[]Transfer{Transfer{StopID:"A09", Route:"A"}, Transfer{StopID:"A09", Route:"C"}}}, Stop{ID:"113", MTAName:"157 St", DisplayName:"157th Street", PhoneticName:"157th Street", Synonyms:[]string{"157th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"114", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"115", MTAName:"137 St - City College", DisplayName:"137th Street, City College", PhoneticName:"137th Street, City College", Synonyms:[]string{"137th Street", "City College", "City College, 137th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"116", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"117", MTAName:"116 St - Columbia University", DisplayName:"116th Street, Columbia University", PhoneticName:"116th Street, Columbia University", Synonyms:[]string{"116th Street", "Columbia University", "Columbia University, 116th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"118", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"119", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"2"}, Transfer{StopID:"120", Route:"3"}}}, Stop{ID:"121", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"121", Route:"2"}}}, Stop{ID:"122", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Synonyms:[]string{"79th Street"}, Transfers:[]Transfer{Transfer{StopID:"122", Route:"2"}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"2"}, Transfer{StopID:"123", Route:"3"}}}, Stop{ID:"124", MTAName:"66 St - Lincoln Center", DisplayName:"66th Street, Lincoln Center", PhoneticName:"66th Street, Lincoln Center", Synonyms:[]string{"66th Street", "Lincoln Center", "Lincoln Center, 66th Street"}, Transfers:[]Transfer{Transfer{StopID:"124", Route:"2"}}}, Stop{ID:"125", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"2"}, Transfer{StopID:"A24", Route:"A"}, Transfer{StopID:"A24", Route:"B"}, Transfer{StopID:"A24", Route:"C"}, Transfer{StopID:"A24", Route:"D"}}}, Stop{ID:"126", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"126", Route:"2"}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"2"}, Transfer{StopID:"128", Route:"3"}}}, Stop{ID:"129", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"129", Route:"2"}}}, Stop{ID:"130", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"130", Route:"2"}}}, Stop{ID:"131", MTAName:"18 St", DisplayName:"18th Street", PhoneticName:"18th Street", Synonyms:[]string{"18th Street"}, Transfers:[]Transfer{Transfer{StopID:"131", Route:"2"}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"2"}, Transfer{StopID:"132", Route:"3"}, Transfer{StopID:"D19", Route:"F"}, Transfer{StopID:"L02", Route:"L"}, Transfer{StopID:"D19", Route:"M"}}}, Stop{ID:"133", MTAName:"Christopher St - Sheridan Sq", DisplayName:"Christopher Street, Sheridan Square", PhoneticName:"Christopher Street, Sheridan Square", Synonyms:[]string{"Christopher Street", "Sheridan Square", "Sheridan Square, Christopher Street"}, Transfers:[]Transfer{Transfer{StopID:"133", Route:"2"}}}, Stop{ID:"134", MTAName:"Houston St", DisplayName:"Houston Street", PhoneticName:"Houston Street", Synonyms:[]string{"Houston Street"}, Transfers:[]Transfer{Transfer{StopID:"134", Route:"2"}}}, Stop{ID:"135", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"135", Route:"2"}}}, Stop{ID:"136", MTAName:"Franklin St", DisplayName:"Franklin Street", PhoneticName:"Franklin Street", Synonyms:[]string{"Franklin Street"}, Transfers:[]Transfer{Transfer{StopID:"136", Route:"2"}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"2"}, Transfer{StopID:"137", Route:"3"}}}, Stop{ID:"138", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"139", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"142", MTAName:"South Ferry", DisplayName:"South Ferry", PhoneticName:"South Ferry", Synonyms:[]string{"South Ferry"}, Transfers:[]Transfer(nil)}}}, "2":Route{Name:"2", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"201", MTAName:"Wakefield - 241 St", DisplayName:"Wakefield - 241st Street", PhoneticName:"Wakefield, 241st Street", Synonyms:[]string{"Wakefield", "241st Street", "241st Street, Wakefield", "Wakefield, 241st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"204", MTAName:"Nereid Av", DisplayName:"Nereid Avenue", PhoneticName:"Nereid Avenue", Synonyms:[]string{"Nereid Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"205", MTAName:"233 St", DisplayName:"233rd Street", PhoneticName:"233rd Street", Synonyms:[]string{"233rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"206", MTAName:"225 St", DisplayName:"225th Street", PhoneticName:"225th Street", Synonyms:[]string{"225th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"207", MTAName:"219 St", DisplayName:"219th Street", PhoneticName:"219th Street", Synonyms:[]string{"219th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"208", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"209", MTAName:"Burke Av", DisplayName:"Burke Avenue", PhoneticName:"Burke Avenue", Synonyms:[]string{"Burke Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"210", MTAName:"Allerton Av", DisplayName:"Allerton Avenue", PhoneticName:"Allerton Avenue", Synonyms:[]string{"Allerton Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"211", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"212", MTAName:"Bronx Park East", DisplayName:"Bronx Park East", PhoneticName:"Bronx Park East", Synonyms:[]string{"Bronx Park East"}, Transfers:[]Transfer(nil)}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"5"}, Transfer{StopID:"213", Route:"5X"}}}, Stop{ID:"214", MTAName:"West Farms Sq - E Tremont Av", DisplayName:"West Farms Square - East Tremont Avenue", PhoneticName:"West Farms Square, East Tremont Avenue", Synonyms:[]string{"West Farms Square", "East Tremont Avenue", "East Tremont Avenue, West Farms Square", "West Farms Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"214", Route:"5"}}}, Stop{ID:"215", MTAName:"174 St", DisplayName:"174th Street", PhoneticName:"174th Street", Synonyms:[]string{"174th Street"}, Transfers:[]Transfer{Transfer{StopID:"215", Route:"5"}}}, Stop{ID:"216", MTAName:"Freeman St", DisplayName:"Freeman Street", PhoneticName:"Freeman Street", Synonyms:[]string{"Freeman Street"}, Transfers:[]Transfer{Transfer{StopID:"216", Route:"5"}}}, Stop{ID:"217", MTAName:"Simpson St", DisplayName:"Simpson Street", PhoneticName:"Simpson Street", Synonyms:[]string{"Simpson Street"}, Transfers:[]Transfer{Transfer{StopID:"217", Route:"5"}}}, Stop{ID:"218", MTAName:"Intervale Av", DisplayName:"Intervale Avenue", PhoneticName:"Intervale Avenue", Synonyms:[]string{"Intervale Avenue"}, Transfers:[]Transfer{Transfer{StopID:"218", Route:"5"}}}, Stop{ID:"219", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"219", Route:"5"}}}, Stop{ID:"220", MTAName:"Jackson Av", DisplayName:"Jackson Avenue", PhoneticName:"Jackson Avenue", Synonyms:[]string{"Jackson Avenue"}, Transfers:[]Transfer{Transfer{StopID:"220", Route:"5"}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"5"}, Transfer{StopID:"221", Route:"5X"}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"415", Route:"4"}, Transfer{StopID:"222", Route:"5"}, Transfer{StopID:"222", Route:"5X"}}}, Stop{ID:"224", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"224", Route:"3"}}}, Stop{ID:"225", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"225", Route:"3"}}}, Stop{ID:"226", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"226", Route:"3"}}}, Stop{ID:"227", MTAName:"Central Park North (110 St)", DisplayName:"Central Park North, 110th Street", PhoneticName:"Central Park North, 110th Street", Synonyms:[]string{"Central Park North", "110th Street", "110th Street, Central Park North"}, Transfers:[]Transfer{Transfer{StopID:"227", Route:"3"}}}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"1"}, Transfer{StopID:"120", Route:"3"}}}, Stop{ID:"121", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"121", Route:"1"}}}, Stop{ID:"122", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Synonyms:[]string{"79th Street"}, Transfers:[]Transfer{Transfer{StopID:"122", Route:"1"}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"1"}, Transfer{StopID:"123", Route:"3"}}}, Stop{ID:"124", MTAName:"66 St - Lincoln Center", DisplayName:"66th Street, Lincoln Center", PhoneticName:"66th Street, Lincoln Center", Synonyms:[]string{"66th Street", "Lincoln Center", "Lincoln Center, 66th Street"}, Transfers:[]Transfer{Transfer{StopID:"124", Route:"1"}}}, Stop{ID:"125", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1"}, Transfer{StopID:"A24", Route:"A"}, Transfer{StopID:"A24", Route:"B"}, Transfer{StopID:"A24", Route:"C"}, Transfer{StopID:"A24", Route:"D"}}}, Stop{ID:"126", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"126", Route:"1"}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"1"}, Transfer{StopID:"128", Route:"3"}}}, Stop{ID:"129", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"129", Route:"1"}}}, Stop{ID:"130", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"130", Route:"1"}}}, Stop{ID:"131", MTAName:"18 St", DisplayName:"18th Street", PhoneticName:"18th Street", Synonyms:[]string{"18th Street"}, Transfers:[]Transfer{Transfer{StopID:"131", Route:"1"}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1"}, Transfer{StopID:"132", Route:"3"}, Transfer{StopID:"D19", Route:"F"}, Transfer{StopID:"L02", Route:"L"}, Transfer{StopID:"D19", Route:"M"}}}, Stop{ID:"133", MTAName:"Christopher St - Sheridan Sq", DisplayName:"Christopher Street, Sheridan Square", PhoneticName:"Christopher Street, Sheridan Square", Synonyms:[]string{"Christopher Street", "Sheridan Square", "Sheridan Square, Christopher Street"}, Transfers:[]Transfer{Transfer{StopID:"133", Route:"1"}}}, Stop{ID:"134", MTAName:"Houston St", DisplayName:"Houston Street", PhoneticName:"Houston Street", Synonyms:[]string{"Houston Street"}, Transfers:[]Transfer{Transfer{StopID:"134", Route:"1"}}}, Stop{ID:"135", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"135", Route:"1"}}}, Stop{ID:"136", MTAName:"Franklin St", DisplayName:"Franklin Street", PhoneticName:"Franklin Street", Synonyms:[]string{"Franklin Street"}, Transfers:[]Transfer{Transfer{StopID:"136", Route:"1"}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"1"}, Transfer{StopID:"137", Route:"3"}}}, Stop{ID:"228", MTAName:"Park Pl", DisplayName:"Park Place", PhoneticName:"Park Place", Synonyms:[]string{"Park Place"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"229", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"230", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"230", Route:"3"}}}, Stop{ID:"231", MTAName:"Clark St", DisplayName:"Clark Street", PhoneticName:"Clark Street", Synonyms:[]string{"Clark Street"}, Transfers:[]Transfer{Transfer{StopID:"231", Route:"3"}}}, Stop{ID:"232", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"233", MTAName:"Hoyt St", DisplayName:"Hoyt Street", PhoneticName:"Hoyt Street", Synonyms:[]string{"Hoyt Street"}, Transfers:[]Transfer{Transfer{StopID:"233", Route:"3"}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"3"}, Transfer{StopID:"234", Route:"4"}, Transfer{StopID:"234", Route:"5"}, Transfer{StopID:"234", Route:"5X"}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"3"}, Transfer{StopID:"236", Route:"4"}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"3"}, Transfer{StopID:"237", Route:"4"}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"3"}, Transfer{StopID:"238", Route:"4"}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"3"}, Transfer{StopID:"239", Route:"4"}, Transfer{StopID:"239", Route:"5"}, Transfer{StopID:"239", Route:"5X"}}}, Stop{ID:"241", MTAName:"President St", DisplayName:"President Street", PhoneticName:"President Street", Synonyms:[]string{"President Street"}, Transfers:[]Transfer{Transfer{StopID:"241", Route:"5X"}}}, Stop{ID:"242", MTAName:"Sterling St", DisplayName:"Sterling Street", PhoneticName:"Sterling Street", Synonyms:[]string{"Sterling Street"}, Transfers:[]Transfer{Transfer{StopID:"242", Route:"5X"}}}, Stop{ID:"243", MTAName:"Winthrop St", DisplayName:"Winthrop Street", PhoneticName:"Winthrop Street", Synonyms:[]string{"Winthrop Street"}, Transfers:[]Transfer{Transfer{StopID:"243", Route:"5X"}}}, Stop{ID:"244", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"244", Route:"5X"}}}, Stop{ID:"245", MTAName:"Beverly Rd", DisplayName:"Beverly Road", PhoneticName:"Beverly Road", Synonyms:[]string{"Beverly Road"}, Transfers:[]Transfer{Transfer{StopID:"245", Route:"5X"}}}, Stop{ID:"246", MTAName:"Newkirk Av", DisplayName:"Newkirk Avenue", PhoneticName:"Newkirk Avenue", Synonyms:[]string{"Newkirk Avenue"}, Transfers:[]Transfer{Transfer{StopID:"246", Route:"5X"}}}, Stop{ID:"247", MTAName:"Flatbush Av - Brooklyn College", DisplayName:"Flatbush Avenue, Brooklyn College", PhoneticName:"Flatbush Avenue, Brooklyn College", Synonyms:[]string{"Flatbush Avenue", "Brooklyn College", "Brooklyn College, Flatbush Avenue"}, Transfers:[]Transfer{Transfer{StopID:"247", Route:"5X"}}}}}, "3":Route{Name:"3", Northbound:"Harlem", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"301", MTAName:"Harlem - 148 St", DisplayName:"Harlem - 148th Street", PhoneticName:"Harlem, 148th Street", Synonyms:[]string{"Harlem", "148th Street", "148th Street, Harlem", "Harlem, 148th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"302", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"224", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"224", Route:"2"}}}, Stop{ID:"225", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"225", Route:"2"}}}, Stop{ID:"226", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"226", Route:"2"}}}, Stop{ID:"227", MTAName:"Central Park North (110 St)", DisplayName:"Central Park North, 110th Street", PhoneticName:"Central Park North, 110th Street", Synonyms:[]string{"Central Park North", "110th Street", "110th Street, Central Park North"}, Transfers:[]Transfer{Transfer{StopID:"227", Route:"2"}}}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"1"}, Transfer{StopID:"120", Route:"2"}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"1"}, Transfer{StopID:"123", Route:"2"}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"1"}, Transfer{StopID:"128", Route:"2"}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1"}, Transfer{StopID:"132", Route:"2"}, Transfer{StopID:"D19", Route:"F"}, Transfer{StopID:"L02", Route:"L"}, Transfer{StopID:"D19", Route:"M"}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"1"}, Transfer{StopID:"137", Route:"2"}}}, Stop{ID:"228", MTAName:"Park Pl", DisplayName:"Park Place", PhoneticName:"Park Place", Synonyms:[]string{"Park Place"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"229", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"230", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"230", Route:"2"}}}, Stop{ID:"231", MTAName:"Clark St", DisplayName:"Clark Street", PhoneticName:"Clark Street", Synonyms:[]string{"Clark Street"}, Transfers:[]Transfer{Transfer{StopID:"231", Route:"2"}}}, Stop{ID:"232", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"233", MTAName:"Hoyt St", DisplayName:"Hoyt Street", PhoneticName:"Hoyt Street", Synonyms:[]string{"Hoyt Street"}, Transfers:[]Transfer{Transfer{StopID:"233", Route:"2"}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2"}, Transfer{StopID:"234", Route:"4"}, Transfer{StopID:"234", Route:"5"}, Transfer{StopID:"234", Route:"5X"}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"2"}, Transfer{StopID:"236", Route:"4"}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"2"}, Transfer{StopID:"237", Route:"4"}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"2"}, Transfer{StopID:"238", Route:"4"}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2"}, Transfer{StopID:"239", Route:"4"}, Transfer{StopID:"239", Route:"5"}, Transfer{StopID:"239", Route:"5X"}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"4"}, Transfer{StopID:"248", Route:"5"}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"4"}, Transfer{StopID:"249", Route:"5"}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"4"}, Transfer{StopID:"250", Route:"5"}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"4"}, Transfer{StopID:"251", Route:"5"}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"4"}, Transfer{StopID:"252", Route:"5"}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"4"}, Transfer{StopID:"253", Route:"5"}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"4"}, Transfer{StopID:"254", Route:"5"}, Transfer{StopID:"L26", Route:"L"}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"4"}, Transfer{StopID:"255", Route:"5"}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"4"}, Transfer{StopID:"256", Route:"5"}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"4"}, Transfer{StopID:"257", Route:"5"}}}}}, "4":Route{Name:"4", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"401", MTAName:"Woodlawn", DisplayName:"Woodlawn", PhoneticName:"Woodlawn", Synonyms:[]string{"Woodlawn"}, Transfers:[]Transfer(nil)}, Stop{ID:"402", MTAName:"Mosholu Pkwy", DisplayName:"Mosholu Parkway", PhoneticName:"Mosholu Parkway", Synonyms:[]string{"Mosholu Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"405", MTAName:"Bedford Park Blvd - Lehman College", DisplayName:"Bedford Park Boulevard - Lehman College", PhoneticName:"Bedford Park Boulevard, Lehman College", Synonyms:[]string{"Bedford Park Boulevard", "Lehman College", "Lehman College, Bedford Park Boulevard", "Bedford Park Boulevard, Lehman College"}, Transfers:[]Transfer(nil)}, Stop{ID:"406", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"407", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"408", MTAName:"183 St", DisplayName:"183rd Street", PhoneticName:"183rd Street", Synonyms:[]string{"183rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"409", MTAName:"Burnside Av", DisplayName:"Burnside Avenue", PhoneticName:"Burnside Avenue", Synonyms:[]string{"Burnside Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"410", MTAName:"176 St", DisplayName:"176th Street", PhoneticName:"176th Street", Synonyms:[]string{"176th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"411", MTAName:"Mt Eden Av", DisplayName:"Mt Eden Avenue", PhoneticName:"Mt Eden Avenue", Synonyms:[]string{"Mt Eden Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"412", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Synonyms:[]string{"170th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"413", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Synonyms:[]string{"167th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"414", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"D11", Route:"B"}, Transfer{StopID:"D11", Route:"D"}}}, Stop{ID:"415", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2"}, Transfer{StopID:"222", Route:"5"}, Transfer{StopID:"222", Route:"5X"}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"5"}, Transfer{StopID:"416", Route:"5X"}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"5"}, Transfer{StopID:"621", Route:"5X"}, Transfer{StopID:"621", Route:"6"}, Transfer{StopID:"621", Route:"6X"}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"6"}, Transfer{StopID:"622", Route:"6X"}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"6"}, Transfer{StopID:"623", Route:"6X"}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"6"}, Transfer{StopID:"624", Route:"6X"}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"6"}, Transfer{StopID:"625", Route:"6X"}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"5"}, Transfer{StopID:"626", Route:"5X"}, Transfer{StopID:"626", Route:"6"}, Transfer{StopID:"626", Route:"6X"}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"6"}, Transfer{StopID:"627", Route:"6X"}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"6"}, Transfer{StopID:"628", Route:"6X"}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"6"}, Transfer{StopID:"630", Route:"6X"}, Transfer{StopID:"F11", Route:"E"}, Transfer{StopID:"F11", Route:"M"}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"5"}, Transfer{StopID:"631", Route:"5X"}, Transfer{StopID:"631", Route:"6"}, Transfer{StopID:"631", Route:"6X"}, Transfer{StopID:"723", Route:"7"}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"6"}, Transfer{StopID:"632", Route:"6X"}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"6"}, Transfer{StopID:"633", Route:"6X"}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"6"}, Transfer{StopID:"634", Route:"6X"}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"6"}, Transfer{StopID:"636", Route:"6X"}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"F"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"6"}, Transfer{StopID:"638", Route:"6X"}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"J"}, Transfer{StopID:"M21", Route:"Z"}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"5"}, Transfer{StopID:"419", Route:"5X"}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"5"}, Transfer{StopID:"420", Route:"5X"}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2"}, Transfer{StopID:"234", Route:"3"}, Transfer{StopID:"234", Route:"5"}, Transfer{StopID:"234", Route:"5X"}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"2"}, Transfer{StopID:"236", Route:"3"}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"2"}, Transfer{StopID:"237", Route:"3"}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"2"}, Transfer{StopID:"238", Route:"3"}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2"}, Transfer{StopID:"239", Route:"3"}, Transfer{StopID:"239", Route:"5"}, Transfer{StopID:"239", Route:"5X"}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"3"}, Transfer{StopID:"248", Route:"5"}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"3"}, Transfer{StopID:"249", Route:"5"}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"3"}, Transfer{StopID:"250", Route:"5"}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"3"}, Transfer{StopID:"251", Route:"5"}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"3"}, Transfer{StopID:"252", Route:"5"}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"3"}, Transfer{StopID:"253", Route:"5"}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3"}, Transfer{StopID:"254", Route:"5"}, Transfer{StopID:"L26", Route:"L"}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"3"}, Transfer{StopID:"255", Route:"5"}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"3"}, Transfer{StopID:"256", Route:"5"}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"3"}, Transfer{StopID:"257", Route:"5"}}}}}, "5":Route{Name:"5", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"501", MTAName:"Eastchester - Dyre Av", DisplayName:"Eastchester - Dyre Avenue", PhoneticName:"Eastchester, Dyre Avenue", Synonyms:[]string{"Eastchester", "Dyre Avenue", "Dyre Avenue, Eastchester", "Eastchester, Dyre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"501", Route:"5X"}}}, Stop{ID:"502", MTAName:"Baychester Av", DisplayName:"Baychester Avenue", PhoneticName:"Baychester Avenue", Synonyms:[]string{"Baychester Avenue"}, Transfers:[]Transfer{Transfer{StopID:"502", Route:"5X"}}}, Stop{ID:"503", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer{Transfer{StopID:"503", Route:"5X"}}}, Stop{ID:"504", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer{Transfer{StopID:"504", Route:"5X"}}}, Stop{ID:"505", MTAName:"Morris Park", DisplayName:"Morris Park", PhoneticName:"Morris Park", Synonyms:[]string{"Morris Park"}, Transfers:[]Transfer{Transfer{StopID:"505", Route:"5X"}}}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"2"}, Transfer{StopID:"213", Route:"5X"}}}, Stop{ID:"214", MTAName:"West Farms Sq - E Tremont Av", DisplayName:"West Farms Square - East Tremont Avenue", PhoneticName:"West Farms Square, East Tremont Avenue", Synonyms:[]string{"West Farms Square", "East Tremont Avenue", "East Tremont Avenue, West Farms Square", "West Farms Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"214", Route:"2"}}}, Stop{ID:"215", MTAName:"174 St", DisplayName:"174th Street", PhoneticName:"174th Street", Synonyms:[]string{"174th Street"}, Transfers:[]Transfer{Transfer{StopID:"215", Route:"2"}}}, Stop{ID:"216", MTAName:"Freeman St", DisplayName:"Freeman Street", PhoneticName:"Freeman Street", Synonyms:[]string{"Freeman Street"}, Transfers:[]Transfer{Transfer{StopID:"216", Route:"2"}}}, Stop{ID:"217", MTAName:"Simpson St", DisplayName:"Simpson Street", PhoneticName:"Simpson Street", Synonyms:[]string{"Simpson Street"}, Transfers:[]Transfer{Transfer{StopID:"217", Route:"2"}}}, Stop{ID:"218", MTAName:"Intervale Av", DisplayName:"Intervale Avenue", PhoneticName:"Intervale Avenue", Synonyms:[]string{"Intervale Avenue"}, Transfers:[]Transfer{Transfer{StopID:"218", Route:"2"}}}, Stop{ID:"219", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"219", Route:"2"}}}, Stop{ID:"220", MTAName:"Jackson Av", DisplayName:"Jackson Avenue", PhoneticName:"Jackson Avenue", Synonyms:[]string{"Jackson Avenue"}, Transfers:[]Transfer{Transfer{StopID:"220", Route:"2"}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"2"}, Transfer{StopID:"221", Route:"5X"}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2"}, Transfer{StopID:"415", Route:"4"}, Transfer{StopID:"222", Route:"5X"}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"4"}, Transfer{StopID:"416", Route:"5X"}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4"}, Transfer{StopID:"621", Route:"5X"}, Transfer{StopID:"621", Route:"6"}, Transfer{StopID:"621", Route:"6X"}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4"}, Transfer{StopID:"626", Route:"5X"}, Transfer{StopID:"626", Route:"6"}, Transfer{StopID:"626", Route:"6X"}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4"}, Transfer{StopID:"631", Route:"5X"}, Transfer{StopID:"631", Route:"6"}, Transfer{StopID:"631", Route:"6X"}, Transfer{StopID:"723", Route:"7"}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"J"}, Transfer{StopID:"M21", Route:"Z"}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"4"}, Transfer{StopID:"419", Route:"5X"}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"4"}, Transfer{StopID:"420", Route:"5X"}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2"}, Transfer{StopID:"234", Route:"3"}, Transfer{StopID:"234", Route:"4"}, Transfer{StopID:"234", Route:"5X"}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2"}, Transfer{StopID:"239", Route:"3"}, Transfer{StopID:"239", Route:"4"}, Transfer{StopID:"239", Route:"5X"}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"3"}, Transfer{StopID:"248", Route:"4"}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"3"}, Transfer{StopID:"249", Route:"4"}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"3"}, Transfer{StopID:"250", Route:"4"}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"3"}, Transfer{StopID:"251", Route:"4"}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"3"}, Transfer{StopID:"252", Route:"4"}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"3"}, Transfer{StopID:"253", Route:"4"}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3"}, Transfer{StopID:"254", Route:"4"}, Transfer{StopID:"L26", Route:"L"}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"3"}, Transfer{StopID:"255", Route:"4"}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"3"}, Transfer{StopID:"256", Route:"4"}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"3"}, Transfer{StopID:"257", Route:"4"}}}}}, "5X":Route{Name:"5X", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"501", MTAName:"Eastchester - Dyre Av", DisplayName:"Eastchester - Dyre Avenue", PhoneticName:"Eastchester, Dyre Avenue", Synonyms:[]string{"Eastchester", "Dyre Avenue", "Dyre Avenue, Eastchester", "Eastchester, Dyre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"501", Route:"5"}}}, Stop{ID:"502", MTAName:"Baychester Av", DisplayName:"Baychester Avenue", PhoneticName:"Baychester Avenue", Synonyms:[]string{"Baychester Avenue"}, Transfers:[]Transfer{Transfer{StopID:"502", Route:"5"}}}, Stop{ID:"503", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer{Transfer{StopID:"503", Route:"5"}}}, Stop{ID:"504", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer{Transfer{StopID:"504", Route:"5"}}}, Stop{ID:"505", MTAName:"Morris Park", DisplayName:"Morris Park", PhoneticName:"Morris Park", Synonyms:[]string{"Morris Park"}, Transfers:[]Transfer{Transfer{StopID:"505", Route:"5"}}}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"2"}, Transfer{StopID:"213", Route:"5"}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"2"}, Transfer{StopID:"221", Route:"5"}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2"}, Transfer{StopID:"415", Route:"4"}, Transfer{StopID:"222", Route:"5"}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"4"}, Transfer{StopID:"416", Route:"5"}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4"}, Transfer{StopID:"621", Route:"5"}, Transfer{StopID:"621", Route:"6"}, Transfer{StopID:"621", Route:"6X"}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4"}, Transfer{StopID:"626", Route:"5"}, Transfer{StopID:"626", Route:"6"}, Transfer{StopID:"626", Route:"6X"}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4"}, Transfer{StopID:"631", Route:"5"}, Transfer{StopID:"631", Route:"6"}, Transfer{StopID:"631", Route:"6X"}, Transfer{StopID:"723", Route:"7"}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"J"}, Transfer{StopID:"M21", Route:"Z"}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"4"}, Transfer{StopID:"419", Route:"5"}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"4"}, Transfer{StopID:"420", Route:"5"}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2"}, Transfer{StopID:"234", Route:"3"}, Transfer{StopID:"234", Route:"4"}, Transfer{StopID:"234", Route:"5"}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2"}, Transfer{StopID:"239", Route:"3"}, Transfer{StopID:"239", Route:"4"}, Transfer{StopID:"239", Route:"5"}}}, Stop{ID:"241", MTAName:"President St", DisplayName:"President Street", PhoneticName:"President Street", Synonyms:[]string{"President Street"}, Transfers:[]Transfer{Transfer{StopID:"241", Route:"2"}}}, Stop{ID:"242", MTAName:"Sterling St", DisplayName:"Sterling Street", PhoneticName:"Sterling Street", Synonyms:[]string{"Sterling Street"}, Transfers:[]Transfer{Transfer{StopID:"242", Route:"2"}}}, Stop{ID:"243", MTAName:"Winthrop St", DisplayName:"Winthrop Street", PhoneticName:"Winthrop Street", Synonyms:[]string{"Winthrop Street"}, Transfers:[]Transfer{Transfer{StopID:"243", Route:"2"}}}, Stop{ID:"244", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"244", Route:"2"}}}, Stop{ID:"245", MTAName:"Beverly Rd", DisplayName:"Beverly Road", PhoneticName:"Beverly Road", Synonyms:[]string{"Beverly Road"}, Transfers:[]Transfer{Transfer{StopID:"245", Route:"2"}}}, Stop{ID:"246", MTAName:"Newkirk Av", DisplayName:"Newkirk Avenue", PhoneticName:"Newkirk Avenue", Synonyms:[]string{"Newkirk Avenue"}, Transfers:[]Transfer{Transfer{StopID:"246", Route:"2"}}}, Stop{ID:"247", MTAName:"Flatbush Av - Brooklyn College", DisplayName:"Flatbush Avenue, Brooklyn College", PhoneticName:"Flatbush Avenue, Brooklyn College", Synonyms:[]string{"Flatbush Avenue", "Brooklyn College", "Brooklyn College, Flatbush Avenue"}, Transfers:[]Transfer{Transfer{StopID:"247", Route:"2"}}}}}, "6":Route{Name:"6", Northbound:"Bronx", Southbound:"Brooklyn Brdg", Stops:[]Stop{Stop{ID:"601", MTAName:"Pelham Bay Park", DisplayName:"Pelham Bay Park", PhoneticName:"Pelham Bay Park", Synonyms:[]string{"Pelham Bay Park"}, Transfers:[]Transfer{Transfer{StopID:"601", Route:"6X"}}}, Stop{ID:"602", MTAName:"Buhre Av", DisplayName:"Buhre Avenue", PhoneticName:"Buhre Avenue", Synonyms:[]string{"Buhre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"602", Route:"6X"}}}, Stop{ID:"603", MTAName:"Middletown Rd", DisplayName:"Middletown Road", PhoneticName:"Middletown Road", Synonyms:[]string{"Middletown Road"}, Transfers:[]Transfer{Transfer{StopID:"603", Route:"6X"}}}, Stop{ID:"604", MTAName:"Westchester Sq - E Tremont Av", DisplayName:"Westchester Square - East Tremont Avenue", PhoneticName:"Westchester Square, East Tremont Avenue", Synonyms:[]string{"Westchester Square", "East Tremont Avenue", "East Tremont Avenue, Westchester Square", "Westchester Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"604", Route:"6X"}}}, Stop{ID:"606", MTAName:"Zerega Av", DisplayName:"Zerega Avenue", PhoneticName:"Zerega Avenue", Synonyms:[]string{"Zerega Avenue"}, Transfers:[]Transfer{Transfer{StopID:"606", Route:"6X"}}}, Stop{ID:"607", MTAName:"Castle Hill Av", DisplayName:"Castle Hill Avenue", PhoneticName:"Castle Hill Avenue", Synonyms:[]string{"Castle Hill Avenue"}, Transfers:[]Transfer{Transfer{StopID:"607", Route:"6X"}}}, Stop{ID:"608", MTAName:"Parkchester", DisplayName:"Parkchester", PhoneticName:"Parkchester", Synonyms:[]string{"Parkchester"}, Transfers:[]Transfer{Transfer{StopID:"608", Route:"6X"}}}, Stop{ID:"609", MTAName:"St Lawrence Av", DisplayName:"Street Lawrence Avenue", PhoneticName:"Street Lawrence Avenue", Synonyms:[]string{"Street Lawrence Avenue"}, Transfers:[]Transfer{Transfer{StopID:"609", Route:"6X"}}}, Stop{ID:"610", MTAName:"Morrison Av- Sound View", DisplayName:"Morrison Av- Sound View", PhoneticName:"Morrison Av- Sound View", Synonyms:[]string{"Morrison Av- Sound View"}, Transfers:[]Transfer{Transfer{StopID:"610", Route:"6X"}}}, Stop{ID:"611", MTAName:"Elder Av", DisplayName:"Elder Avenue", PhoneticName:"Elder Avenue", Synonyms:[]string{"Elder Avenue"}, Transfers:[]Transfer{Transfer{StopID:"611", Route:"6X"}}}, Stop{ID:"612", MTAName:"Whitlock Av", DisplayName:"Whitlock Avenue", PhoneticName:"Whitlock Avenue", Synonyms:[]string{"Whitlock Avenue"}, Transfers:[]Transfer{Transfer{StopID:"612", Route:"6X"}}}, Stop{ID:"613", MTAName:"Hunts Point Av", DisplayName:"Hunts Point Avenue", PhoneticName:"Hunts Point Avenue", Synonyms:[]string{"Hunts Point Avenue"}, Transfers:[]Transfer{Transfer{StopID:"613", Route:"6X"}}}, Stop{ID:"614", MTAName:"Longwood Av", DisplayName:"Longwood Avenue", PhoneticName:"Longwood Avenue", Synonyms:[]string{"Longwood Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"615", MTAName:"E 149 St", DisplayName:"East 149th Street", PhoneticName:"East 149th Street", Synonyms:[]string{"East 149th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"616", MTAName:"E 143 St - St Mary's St", DisplayName:"East 143rd Street, Street Mary's Street", PhoneticName:"East 143rd Street, Street Mary's Street", Synonyms:[]string{"East 143rd Street", "Street Mary's Street", "Street Mary's Street, East 143rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"617", MTAName:"Cypress Av", DisplayName:"Cypress Avenue", PhoneticName:"Cypress Avenue", Synonyms:[]string{"Cypress Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"618", MTAName:"Brook Av", DisplayName:"Brook Avenue", PhoneticName:"Brook Avenue", Synonyms:[]string{"Brook Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"619", MTAName:"3 Av - 138 St", DisplayName:"3rd Avenue, 138th Street", PhoneticName:"3rd Avenue, 138th Street", Synonyms:[]string{"3rd Avenue", "138th Street", "138th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"619", Route:"6X"}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4"}, Transfer{StopID:"621", Route:"5"}, Transfer{StopID:"621", Route:"5X"}, Transfer{StopID:"621", Route:"6X"}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"4"}, Transfer{StopID:"622", Route:"6X"}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"4"}, Transfer{StopID:"623", Route:"6X"}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"4"}, Transfer{StopID:"624", Route:"6X"}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"4"}, Transfer{StopID:"625", Route:"6X"}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4"}, Transfer{StopID:"626", Route:"5"}, Transfer{StopID:"626", Route:"5X"}, Transfer{StopID:"626", Route:"6X"}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"4"}, Transfer{StopID:"627", Route:"6X"}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"4"}, Transfer{StopID:"628", Route:"6X"}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4"}, Transfer{StopID:"630", Route:"6X"}, Transfer{StopID:"F11", Route:"E"}, Transfer{StopID:"F11", Route:"M"}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4"}, Transfer{StopID:"631", Route:"5"}, Transfer{StopID:"631", Route:"5X"}, Transfer{StopID:"631", Route:"6X"}, Transfer{StopID:"723", Route:"7"}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"4"}, Transfer{StopID:"632", Route:"6X"}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"4"}, Transfer{StopID:"633", Route:"6X"}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"4"}, Transfer{StopID:"634", Route:"6X"}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"4"}, Transfer{StopID:"636", Route:"6X"}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"F"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"4"}, Transfer{StopID:"638", Route:"6X"}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"J"}, Transfer{StopID:"M21", Route:"Z"}}}}}, "6X":Route{Name:"6X", Northbound:"Bronx", Southbound:"Brooklyn Brdg", Stops:[]Stop{Stop{ID:"601", MTAName:"Pelham Bay Park", DisplayName:"Pelham Bay Park", PhoneticName:"Pelham Bay Park", Synonyms:[]string{"Pelham Bay Park"}, Transfers:[]Transfer{Transfer{StopID:"601", Route:"6"}}}, Stop{ID:"602", MTAName:"Buhre Av", DisplayName:"Buhre Avenue", PhoneticName:"Buhre Avenue", Synonyms:[]string{"Buhre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"602", Route:"6"}}}, Stop{ID:"603", MTAName:"Middletown Rd", DisplayName:"Middletown Road", PhoneticName:"Middletown Road", Synonyms:[]string{"Middletown Road"}, Transfers:[]Transfer{Transfer{StopID:"603", Route:"6"}}}, Stop{ID:"604", MTAName:"Westchester Sq - E Tremont Av", DisplayName:"Westchester Square - East Tremont Avenue", PhoneticName:"Westchester Square, East Tremont Avenue", Synonyms:[]string{"Westchester Square", "East Tremont Avenue", "East Tremont Avenue, Westchester Square", "Westchester Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"604", Route:"6"}}}, Stop{ID:"606", MTAName:"Zerega Av", DisplayName:"Zerega Avenue", PhoneticName:"Zerega Avenue", Synonyms:[]string{"Zerega Avenue"}, Transfers:[]Transfer{Transfer{StopID:"606", Route:"6"}}}, Stop{ID:"607", MTAName:"Castle Hill Av", DisplayName:"Castle Hill Avenue", PhoneticName:"Castle Hill Avenue", Synonyms:[]string{"Castle Hill Avenue"}, Transfers:[]Transfer{Transfer{StopID:"607", Route:"6"}}}, Stop{ID:"608", MTAName:"Parkchester", DisplayName:"Parkchester", PhoneticName:"Parkchester", Synonyms:[]string{"Parkchester"}, Transfers:[]Transfer{Transfer{StopID:"608", Route:"6"}}}, Stop{ID:"609", MTAName:"St Lawrence Av", DisplayName:"Street Lawrence Avenue", PhoneticName:"Street Lawrence Avenue", Synonyms:[]string{"Street Lawrence Avenue"}, Transfers:[]Transfer{Transfer{StopID:"609", Route:"6"}}}, Stop{ID:"610", MTAName:"Morrison Av- Sound View", DisplayName:"Morrison Av- Sound View", PhoneticName:"Morrison Av- Sound View", Synonyms:[]string{"Morrison Av- Sound View"}, Transfers:[]Transfer{Transfer{StopID:"610", Route:"6"}}}, Stop{ID:"611", MTAName:"Elder Av", DisplayName:"Elder Avenue", PhoneticName:"Elder Avenue", Synonyms:[]string{"Elder Avenue"}, Transfers:[]Transfer{Transfer{StopID:"611", Route:"6"}}}, Stop{ID:"612", MTAName:"Whitlock Av", DisplayName:"Whitlock Avenue", PhoneticName:"Whitlock Avenue", Synonyms:[]string{"Whitlock Avenue"}, Transfers:[]Transfer{Transfer{StopID:"612", Route:"6"}}}, Stop{ID:"613", MTAName:"Hunts Point Av", DisplayName:"Hunts Point Avenue", PhoneticName:"Hunts Point Avenue", Synonyms:[]string{"Hunts Point Avenue"}, Transfers:[]Transfer{Transfer{StopID:"613", Route:"6"}}}, Stop{ID:"619", MTAName:"3 Av - 138 St", DisplayName:"3rd Avenue, 138th Street", PhoneticName:"3rd Avenue, 138th Street", Synonyms:[]string{"3rd Avenue", "138th Street", "138th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"619", Route:"6"}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4"}, Transfer{StopID:"621", Route:"5"}, Transfer{StopID:"621", Route:"5X"}, Transfer{StopID:"621", Route:"6"}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"4"}, Transfer{StopID:"622", Route:"6"}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"4"}, Transfer{StopID:"623", Route:"6"}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"4"}, Transfer{StopID:"624", Route:"6"}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"4"}, Transfer{StopID:"625", Route:"6"}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4"}, Transfer{StopID:"626", Route:"5"}, Transfer{StopID:"626", Route:"5X"}, Transfer{StopID:"626", Route:"6"}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"4"}, Transfer{StopID:"627", Route:"6"}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"4"}, Transfer{StopID:"628", Route:"6"}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4"}, Transfer{StopID:"630", Route:"6"}, Transfer{StopID:"F11", Route:"E"}, Transfer{StopID:"F11", Route:"M"}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4"}, Transfer{StopID:"631", Route:"5"}, Transfer{StopID:"631", Route:"5X"}, Transfer{StopID:"631", Route:"6"}, Transfer{StopID:"723", Route:"7"}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"4"}, Transfer{StopID:"632", Route:"6"}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"4"}, Transfer{StopID:"633", Route:"6"}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"4"}, Transfer{StopID:"634", Route:"6"}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"4"}, Transfer{StopID:"636", Route:"6"}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"F"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"4"}, Transfer{StopID:"638", Route:"6"}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"M21", Route:"J"}, Transfer{StopID:"M21", Route:"Z"}}}}}, "7":Route{Name:"7", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"701", MTAName:"Flushing - Main St", DisplayName:"Flushing - Main Street", PhoneticName:"Flushing, Main Street", Synonyms:[]string{"Flushing", "Main Street", "Main Street, Flushing", "Flushing, Main Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"702", MTAName:"Mets - Willets Point", DisplayName:"Mets - Willets Point", PhoneticName:"Mets, Willets Point", Synonyms:[]string{"Mets", "Willets Point", "Willets Point, Mets", "Mets, Willets Point"}, Transfers:[]Transfer(nil)}, Stop{ID:"705", MTAName:"111 St", DisplayName:"111st Street", PhoneticName:"111st Street", Synonyms:[]string{"111st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"706", MTAName:"103 St - Corona Plaza", DisplayName:"103rd Street, Corona Plaza", PhoneticName:"103rd Street, Corona Plaza", Synonyms:[]string{"103rd Street", "Corona Plaza", "Corona Plaza, 103rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"707", MTAName:"Junction Blvd", DisplayName:"Junction Boulevard", PhoneticName:"Junction Boulevard", Synonyms:[]string{"Junction Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"708", MTAName:"90 St - Elmhurst Av", DisplayName:"90th Street, Elmhurst Avenue", PhoneticName:"90th Street, Elmhurst Avenue", Synonyms:[]string{"90th Street", "Elmhurst Avenue", "Elmhurst Avenue, 90th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"709", MTAName:"82 St - Jackson Hts", DisplayName:"82nd Street, Jackson Heights", PhoneticName:"82nd Street, Jackson Heights", Synonyms:[]string{"82nd Street", "Jackson Heights", "Jackson Heights, 82nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"710", MTAName:"74 St - Broadway", DisplayName:"74th Street, Broadway", PhoneticName:"74th Street, Broadway", Synonyms:[]string{"74th Street", "Broadway", "Broadway, 74th Street"}, Transfers:[]Transfer{Transfer{StopID:"G14", Route:"E"}, Transfer{StopID:"G14", Route:"F"}, Transfer{StopID:"G14", Route:"M"}, Transfer{StopID:"G14", Route:"R"}}}, Stop{ID:"711", MTAName:"69 St", DisplayName:"69th Street", PhoneticName:"69th Street", Synonyms:[]string{"69th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"712", MTAName:"Woodside - 61 St", DisplayName:"Woodside - 61st Street", PhoneticName:"Woodside, 61st Street", Synonyms:[]string{"Woodside", "61st Street", "61st Street, Woodside", "Woodside, 61st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"713", MTAName:"52 St", DisplayName:"52nd Street", PhoneticName:"52nd Street", Synonyms:[]string{"52nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"714", MTAName:"46 St - Bliss St", DisplayName:"46th Street, Bliss Street", PhoneticName:"46th Street, Bliss Street", Synonyms:[]string{"46th Street", "Bliss Street", "Bliss Street, 46th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"715", MTAName:"40 St - Lowery St", DisplayName:"40th Street, Lowery Street", PhoneticName:"40th Street, Lowery Street", Synonyms:[]string{"40th Street", "Lowery Street", "Lowery Street, 40th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"716", MTAName:"33 St - Rawson St", DisplayName:"33rd Street, Rawson Street", PhoneticName:"33rd Street, Rawson Street", Synonyms:[]string{"33rd Street", "Rawson Street", "Rawson Street, 33rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"718", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"R09", Route:"N"}, Transfer{StopID:"R09", Route:"W"}}}, Stop{ID:"719", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"F09", Route:"E"}, Transfer{StopID:"G22", Route:"G"}, Transfer{StopID:"F09", Route:"M"}}}, Stop{ID:"720", MTAName:"Hunters Point Av", DisplayName:"Hunters Point Avenue", PhoneticName:"Hunters Point Avenue", Synonyms:[]string{"Hunters Point Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"721", MTAName:"Vernon Blvd - Jackson Av", DisplayName:"Vernon Boulevard - Jackson Avenue", PhoneticName:"Vernon Boulevard, Jackson Avenue", Synonyms:[]string{"Vernon Boulevard", "Jackson Avenue", "Jackson Avenue, Vernon Boulevard", "Vernon Boulevard, Jackson Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"723", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4"}, Transfer{StopID:"631", Route:"5"}, Transfer{StopID:"631", Route:"5X"}, Transfer{StopID:"631", Route:"6"}, Transfer{StopID:"631", Route:"6X"}}}, Stop{ID:"724", MTAName:"5 Av", DisplayName:"5th Avenue", PhoneticName:"5th Avenue", Synonyms:[]string{"5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D16", Route:"B"}, Transfer{StopID:"D16", Route:"D"}, Transfer{StopID:"D16", Route:"F"}, Transfer{StopID:"D16", Route:"M"}}}, Stop{ID:"725", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"726", MTAName:"34 St - Hudson Yds", DisplayName:"34th Street, Hudson Yds", PhoneticName:"34th Street, Hudson Yds", Synonyms:[]string{"34th Street", "Hudson Yds", "Hudson Yds, 34th Street"}, Transfers:[]Transfer(nil)}}}, "A":Route{Name:"A", Northbound:"Manhattan", Southbound:"Queens", Stops:[]Stop{Stop{ID:"A02", MTAName:"Inwood - 207 St", DisplayName:"Inwood - 207th Street", PhoneticName:"Inwood, 207th Street", Synonyms:[]string{"Inwood", "207th Street", "207th Street, Inwood", "Inwood, 207th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A03", MTAName:"Dyckman St", DisplayName:"Dyckman Street", PhoneticName:"Dyckman Street", Synonyms:[]string{"Dyckman Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A05", MTAName:"190 St", DisplayName:"190th Street", PhoneticName:"190th Street", Synonyms:[]string{"190th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A06", MTAName:"181 St", DisplayName:"181st Street", PhoneticName:"181st Street", Synonyms:[]string{"181st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A07", MTAName:"175 St", DisplayName:"175th Street", PhoneticName:"175th Street", Synonyms:[]string{"175th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A09", MTAName:"168 St", DisplayName:"168th Street", PhoneticName:"168th Street", Synonyms:[]string{"168th Street"}, Transfers:[]Transfer{Transfer{StopID:"112", Route:"1"}, Transfer{StopID:"A09", Route:"C"}}}, Stop{ID:"A10", MTAName:"163 St - Amsterdam Av", DisplayName:"163rd Street, Amsterdam Avenue", PhoneticName:"163rd Street, Amsterdam Avenue", Synonyms:[]string{"163rd Street", "Amsterdam Avenue", "Amsterdam Avenue, 163rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A10", Route:"C"}}}, Stop{ID:"A11", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"A11", Route:"C"}}}, Stop{ID:"A12", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"D13", Route:"B"}, Transfer{StopID:"A12", Route:"C"}, Transfer{StopID:"D13", Route:"D"}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"B"}, Transfer{StopID:"A14", Route:"C"}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"B"}, Transfer{StopID:"A15", Route:"C"}, Transfer{StopID:"A15", Route:"D"}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"B"}, Transfer{StopID:"A16", Route:"C"}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"B"}, Transfer{StopID:"A17", Route:"C"}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"B"}, Transfer{StopID:"A18", Route:"C"}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"B"}, Transfer{StopID:"A19", Route:"C"}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"B"}, Transfer{StopID:"A20", Route:"C"}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"B"}, Transfer{StopID:"A21", Route:"C"}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"B"}, Transfer{StopID:"A22", Route:"C"}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1"}, Transfer{StopID:"125", Route:"2"}, Transfer{StopID:"A24", Route:"B"}, Transfer{StopID:"A24", Route:"C"}, Transfer{StopID:"A24", Route:"D"}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"C"}, Transfer{StopID:"A25", Route:"E"}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"C"}, Transfer{StopID:"A28", Route:"E"}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"C"}, Transfer{StopID:"A30", Route:"E"}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"C"}, Transfer{StopID:"A31", Route:"E"}, Transfer{StopID:"L01", Route:"L"}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"F"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"C"}, Transfer{StopID:"A33", Route:"E"}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"C"}, Transfer{StopID:"A34", Route:"E"}}}, Stop{ID:"A36", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"A38", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"A40", MTAName:"High St", DisplayName:"High Street", PhoneticName:"High Street", Synonyms:[]string{"High Street"}, Transfers:[]Transfer{Transfer{StopID:"A40", Route:"C"}}}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"C"}, Transfer{StopID:"A41", Route:"F"}, Transfer{StopID:"R29", Route:"N"}, Transfer{StopID:"R29", Route:"R"}, Transfer{StopID:"R29", Route:"W"}}}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"C"}, Transfer{StopID:"A42", Route:"G"}}}, Stop{ID:"A43", MTAName:"Lafayette Av", DisplayName:"Lafayette Avenue", PhoneticName:"Lafayette Avenue", Synonyms:[]string{"Lafayette Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A43", Route:"C"}}}, Stop{ID:"A44", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A44", Route:"C"}}}, Stop{ID:"A45", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A45", Route:"C"}}}, Stop{ID:"A46", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A46", Route:"C"}}}, Stop{ID:"A47", MTAName:"Kingston - Throop Avs", DisplayName:"Kingston - Throop Avenues", PhoneticName:"Kingston, Throop Avenues", Synonyms:[]string{"Kingston", "Throop Avenues", "Throop Avenues, Kingston", "Kingston, Throop Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A47", Route:"C"}}}, Stop{ID:"A48", MTAName:"Utica Av", DisplayName:"Utica Avenue", PhoneticName:"Utica Avenue", Synonyms:[]string{"Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A48", Route:"C"}}}, Stop{ID:"A49", MTAName:"Ralph Av", DisplayName:"Ralph Avenue", PhoneticName:"Ralph Avenue", Synonyms:[]string{"Ralph Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A49", Route:"C"}}}, Stop{ID:"A50", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A50", Route:"C"}}}, Stop{ID:"A51", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"C"}, Transfer{StopID:"J27", Route:"J"}, Transfer{StopID:"L22", Route:"L"}, Transfer{StopID:"J27", Route:"Z"}}}, Stop{ID:"A52", MTAName:"Liberty Av", DisplayName:"Liberty Avenue", PhoneticName:"Liberty Avenue", Synonyms:[]string{"Liberty Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A52", Route:"C"}}}, Stop{ID:"A53", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A53", Route:"C"}}}, Stop{ID:"A54", MTAName:"Shepherd Av", DisplayName:"Shepherd Avenue", PhoneticName:"Shepherd Avenue", Synonyms:[]string{"Shepherd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A54", Route:"C"}}}, Stop{ID:"A55", MTAName:"Euclid Av", DisplayName:"Euclid Avenue", PhoneticName:"Euclid Avenue", Synonyms:[]string{"Euclid Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A55", Route:"C"}}}, Stop{ID:"A57", MTAName:"Grant Av", DisplayName:"Grant Avenue", PhoneticName:"Grant Avenue", Synonyms:[]string{"Grant Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"A59", MTAName:"80 St", DisplayName:"80th Street", PhoneticName:"80th Street", Synonyms:[]string{"80th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A60", MTAName:"88 St", DisplayName:"88th Street", PhoneticName:"88th Street", Synonyms:[]string{"88th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A61", MTAName:"Rockaway Blvd", DisplayName:"Rockaway Boulevard", PhoneticName:"Rockaway Boulevard", Synonyms:[]string{"Rockaway Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"H01", MTAName:"Aqueduct Racetrack", DisplayName:"Aqueduct Racetrack", PhoneticName:"Aqueduct Racetrack", Synonyms:[]string{"Aqueduct Racetrack"}, Transfers:[]Transfer(nil)}, Stop{ID:"H02", MTAName:"Aqueduct - N Conduit Av", DisplayName:"Aqueduct - North Conduit Avenue", PhoneticName:"Aqueduct, North Conduit Avenue", Synonyms:[]string{"Aqueduct", "North Conduit Avenue", "North Conduit Avenue, Aqueduct", "Aqueduct, North Conduit Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"H03", MTAName:"Howard Beach - JFK Airport", DisplayName:"Howard Beach - JFK Airport", PhoneticName:"Howard Beach, JFK Airport", Synonyms:[]string{"Howard Beach", "JFK Airport", "JFK Airport, Howard Beach", "Howard Beach, JFK Airport"}, Transfers:[]Transfer(nil)}, Stop{ID:"H04", MTAName:"Broad Channel", DisplayName:"Broad Channel", PhoneticName:"Broad Channel", Synonyms:[]string{"Broad Channel"}, Transfers:[]Transfer(nil)}, Stop{ID:"H06", MTAName:"Beach 67 St", DisplayName:"Beach 67th Street", PhoneticName:"Beach 67th Street", Synonyms:[]string{"Beach 67th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H07", MTAName:"Beach 60 St", DisplayName:"Beach 60th Street", PhoneticName:"Beach 60th Street", Synonyms:[]string{"Beach 60th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H08", MTAName:"Beach 44 St", DisplayName:"Beach 44th Street", PhoneticName:"Beach 44th Street", Synonyms:[]string{"Beach 44th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H09", MTAName:"Beach 36 St", DisplayName:"Beach 36th Street", PhoneticName:"Beach 36th Street", Synonyms:[]string{"Beach 36th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H10", MTAName:"Beach 25 St", DisplayName:"Beach 25th Street", PhoneticName:"Beach 25th Street", Synonyms:[]string{"Beach 25th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H11", MTAName:"Far Rockaway - Mott Av", DisplayName:"Far Rockaway - Mott Avenue", PhoneticName:"Far Rockaway, Mott Avenue", Synonyms:[]string{"Far Rockaway", "Mott Avenue", "Mott Avenue, Far Rockaway", "Far Rockaway, Mott Avenue"}, Transfers:[]Transfer(nil)}}}, "B":Route{Name:"B", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"D03", MTAName:"Bedford Park Blvd", DisplayName:"Bedford Park Boulevard", PhoneticName:"Bedford Park Boulevard", Synonyms:[]string{"Bedford Park Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"D03", Route:"D"}}}, Stop{ID:"D04", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer{Transfer{StopID:"D04", Route:"D"}}}, Stop{ID:"D05", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer{Transfer{StopID:"D05", Route:"D"}}}, Stop{ID:"D06", MTAName:"182-183 Sts", DisplayName:"182nd 183rd Streets", PhoneticName:"182nd 183rd Streets", Synonyms:[]string{"182nd 183rd Streets"}, Transfers:[]Transfer{Transfer{StopID:"D06", Route:"D"}}}, Stop{ID:"D07", MTAName:"Tremont Av", DisplayName:"Tremont Avenue", PhoneticName:"Tremont Avenue", Synonyms:[]string{"Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D07", Route:"D"}}}, Stop{ID:"D08", MTAName:"174-175 Sts", DisplayName:"174th 175th Streets", PhoneticName:"174th 175th Streets", Synonyms:[]string{"174th 175th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D08", Route:"D"}}}, Stop{ID:"D09", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Synonyms:[]string{"170th Street"}, Transfers:[]Transfer{Transfer{StopID:"D09", Route:"D"}}}, Stop{ID:"D10", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Synonyms:[]string{"167th Street"}, Transfers:[]Transfer{Transfer{StopID:"D10", Route:"D"}}}, Stop{ID:"D11", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"414", Route:"4"}, Transfer{StopID:"D11", Route:"D"}}}, Stop{ID:"D12", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"D12", Route:"D"}}}, Stop{ID:"D13", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A"}, Transfer{StopID:"A12", Route:"C"}, Transfer{StopID:"D13", Route:"D"}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"A"}, Transfer{StopID:"A14", Route:"C"}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A"}, Transfer{StopID:"A15", Route:"C"}, Transfer{StopID:"A15", Route:"D"}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"A"}, Transfer{StopID:"A16", Route:"C"}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"A"}, Transfer{StopID:"A17", Route:"C"}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"A"}, Transfer{StopID:"A18", Route:"C"}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"A"}, Transfer{StopID:"A19", Route:"C"}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"A"}, Transfer{StopID:"A20", Route:"C"}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"A"}, Transfer{StopID:"A21", Route:"C"}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"A"}, Transfer{StopID:"A22", Route:"C"}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1"}, Transfer{StopID:"125", Route:"2"}, Transfer{StopID:"A24", Route:"A"}, Transfer{StopID:"A24", Route:"C"}, Transfer{StopID:"A24", Route:"D"}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"D"}, Transfer{StopID:"D14", Route:"E"}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"D"}, Transfer{StopID:"D15", Route:"F"}, Transfer{StopID:"D15", Route:"M"}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7"}, Transfer{StopID:"D16", Route:"D"}, Transfer{StopID:"D16", Route:"F"}, Transfer{StopID:"D16", Route:"M"}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"F"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"F"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"D22", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer{Transfer{StopID:"D22", Route:"D"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"D"}, Transfer{StopID:"R30", Route:"N"}, Transfer{StopID:"R30", Route:"Q"}, Transfer{StopID:"R30", Route:"R"}, Transfer{StopID:"R30", Route:"W"}}}, Stop{ID:"D24", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"D25", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D25", Route:"Q"}}}, Stop{ID:"D26", MTAName:"Prospect Park", DisplayName:"Prospect Park", PhoneticName:"Prospect Park", Synonyms:[]string{"Prospect Park"}, Transfers:[]Transfer{Transfer{StopID:"D26", Route:"Q"}}}, Stop{ID:"D28", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D28", Route:"Q"}}}, Stop{ID:"D31", MTAName:"Newkirk Plaza", DisplayName:"Newkirk Plaza", PhoneticName:"Newkirk Plaza", Synonyms:[]string{"Newkirk Plaza"}, Transfers:[]Transfer{Transfer{StopID:"D31", Route:"Q"}}}, Stop{ID:"D35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"D35", Route:"Q"}}}, Stop{ID:"D39", MTAName:"Sheepshead Bay", DisplayName:"Sheepshead Bay", PhoneticName:"Sheepshead Bay", Synonyms:[]string{"Sheepshead Bay"}, Transfers:[]Transfer{Transfer{StopID:"D39", Route:"Q"}}}, Stop{ID:"D40", MTAName:"Brighton Beach", DisplayName:"Brighton Beach", PhoneticName:"Brighton Beach", Synonyms:[]string{"Brighton Beach"}, Transfers:[]Transfer{Transfer{StopID:"D40", Route:"Q"}}}}}, "C":Route{Name:"C", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"A09", MTAName:"168 St", DisplayName:"168th Street", PhoneticName:"168th Street", Synonyms:[]string{"168th Street"}, Transfers:[]Transfer{Transfer{StopID:"112", Route:"1"}, Transfer{StopID:"A09", Route:"A"}}}, Stop{ID:"A10", MTAName:"163 St - Amsterdam Av", DisplayName:"163rd Street, Amsterdam Avenue", PhoneticName:"163rd Street, Amsterdam Avenue", Synonyms:[]string{"163rd Street", "Amsterdam Avenue", "Amsterdam Avenue, 163rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A10", Route:"A"}}}, Stop{ID:"A11", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"A11", Route:"A"}}}, Stop{ID:"A12", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A"}, Transfer{StopID:"D13", Route:"B"}, Transfer{StopID:"D13", Route:"D"}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"A"}, Transfer{StopID:"A14", Route:"B"}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A"}, Transfer{StopID:"A15", Route:"B"}, Transfer{StopID:"A15", Route:"D"}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"A"}, Transfer{StopID:"A16", Route:"B"}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"A"}, Transfer{StopID:"A17", Route:"B"}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"A"}, Transfer{StopID:"A18", Route:"B"}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"A"}, Transfer{StopID:"A19", Route:"B"}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"A"}, Transfer{StopID:"A20", Route:"B"}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"A"}, Transfer{StopID:"A21", Route:"B"}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"A"}, Transfer{StopID:"A22", Route:"B"}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1"}, Transfer{StopID:"125", Route:"2"}, Transfer{StopID:"A24", Route:"A"}, Transfer{StopID:"A24", Route:"B"}, Transfer{StopID:"A24", Route:"D"}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"A"}, Transfer{StopID:"A25", Route:"E"}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"A"}, Transfer{StopID:"A28", Route:"E"}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"A"}, Transfer{StopID:"A30", Route:"E"}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A"}, Transfer{StopID:"A31", Route:"E"}, Transfer{StopID:"L01", Route:"L"}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"F"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"A"}, Transfer{StopID:"A33", Route:"E"}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"A"}, Transfer{StopID:"A34", Route:"E"}}}, Stop{ID:"A36", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"A38", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"M22", Route:"J"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"A40", MTAName:"High St", DisplayName:"High Street", PhoneticName:"High Street", Synonyms:[]string{"High Street"}, Transfers:[]Transfer{Transfer{StopID:"A40", Route:"A"}}}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A"}, Transfer{StopID:"A41", Route:"F"}, Transfer{StopID:"R29", Route:"N"}, Transfer{StopID:"R29", Route:"R"}, Transfer{StopID:"R29", Route:"W"}}}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"A"}, Transfer{StopID:"A42", Route:"G"}}}, Stop{ID:"A43", MTAName:"Lafayette Av", DisplayName:"Lafayette Avenue", PhoneticName:"Lafayette Avenue", Synonyms:[]string{"Lafayette Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A43", Route:"A"}}}, Stop{ID:"A44", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A44", Route:"A"}}}, Stop{ID:"A45", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A45", Route:"A"}}}, Stop{ID:"A46", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A46", Route:"A"}}}, Stop{ID:"A47", MTAName:"Kingston - Throop Avs", DisplayName:"Kingston - Throop Avenues", PhoneticName:"Kingston, Throop Avenues", Synonyms:[]string{"Kingston", "Throop Avenues", "Throop Avenues, Kingston", "Kingston, Throop Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A47", Route:"A"}}}, Stop{ID:"A48", MTAName:"Utica Av", DisplayName:"Utica Avenue", PhoneticName:"Utica Avenue", Synonyms:[]string{"Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A48", Route:"A"}}}, Stop{ID:"A49", MTAName:"Ralph Av", DisplayName:"Ralph Avenue", PhoneticName:"Ralph Avenue", Synonyms:[]string{"Ralph Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A49", Route:"A"}}}, Stop{ID:"A50", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A50", Route:"A"}}}, Stop{ID:"A51", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A"}, Transfer{StopID:"J27", Route:"J"}, Transfer{StopID:"L22", Route:"L"}, Transfer{StopID:"J27", Route:"Z"}}}, Stop{ID:"A52", MTAName:"Liberty Av", DisplayName:"Liberty Avenue", PhoneticName:"Liberty Avenue", Synonyms:[]string{"Liberty Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A52", Route:"A"}}}, Stop{ID:"A53", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A53", Route:"A"}}}, Stop{ID:"A54", MTAName:"Shepherd Av", DisplayName:"Shepherd Avenue", PhoneticName:"Shepherd Avenue", Synonyms:[]string{"Shepherd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A54", Route:"A"}}}, Stop{ID:"A55", MTAName:"Euclid Av", DisplayName:"Euclid Avenue", PhoneticName:"Euclid Avenue", Synonyms:[]string{"Euclid Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A55", Route:"A"}}}}}, "D":Route{Name:"D", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"D01", MTAName:"Norwood - 205 St", DisplayName:"Norwood - 205th Street", PhoneticName:"Norwood, 205th Street", Synonyms:[]string{"Norwood", "205th Street", "205th Street, Norwood", "Norwood, 205th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D03", MTAName:"Bedford Park Blvd", DisplayName:"Bedford Park Boulevard", PhoneticName:"Bedford Park Boulevard", Synonyms:[]string{"Bedford Park Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"D03", Route:"B"}}}, Stop{ID:"D04", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer{Transfer{StopID:"D04", Route:"B"}}}, Stop{ID:"D05", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer{Transfer{StopID:"D05", Route:"B"}}}, Stop{ID:"D06", MTAName:"182-183 Sts", DisplayName:"182nd 183rd Streets", PhoneticName:"182nd 183rd Streets", Synonyms:[]string{"182nd 183rd Streets"}, Transfers:[]Transfer{Transfer{StopID:"D06", Route:"B"}}}, Stop{ID:"D07", MTAName:"Tremont Av", DisplayName:"Tremont Avenue", PhoneticName:"Tremont Avenue", Synonyms:[]string{"Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D07", Route:"B"}}}, Stop{ID:"D08", MTAName:"174-175 Sts", DisplayName:"174th 175th Streets", PhoneticName:"174th 175th Streets", Synonyms:[]string{"174th 175th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D08", Route:"B"}}}, Stop{ID:"D09", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Synonyms:[]string{"170th Street"}, Transfers:[]Transfer{Transfer{StopID:"D09", Route:"B"}}}, Stop{ID:"D10", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Synonyms:[]string{"167th Street"}, Transfers:[]Transfer{Transfer{StopID:"D10", Route:"B"}}}, Stop{ID:"D11", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"414", Route:"4"}, Transfer{StopID:"D11", Route:"B"}}}, Stop{ID:"D12", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"D12", Route:"B"}}}, Stop{ID:"D13", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A"}, Transfer{StopID:"D13", Route:"B"}, Transfer{StopID:"A12", Route:"C"}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A"}, Transfer{StopID:"A15", Route:"B"}, Transfer{StopID:"A15", Route:"C"}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1"}, Transfer{StopID:"125", Route:"2"}, Transfer{StopID:"A24", Route:"A"}, Transfer{StopID:"A24", Route:"B"}, Transfer{StopID:"A24", Route:"C"}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"B"}, Transfer{StopID:"D14", Route:"E"}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B"}, Transfer{StopID:"D15", Route:"F"}, Transfer{StopID:"D15", Route:"M"}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7"}, Transfer{StopID:"D16", Route:"B"}, Transfer{StopID:"D16", Route:"F"}, Transfer{StopID:"D16", Route:"M"}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"F"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"F"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"D22", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer{Transfer{StopID:"D22", Route:"B"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B"}, Transfer{StopID:"R30", Route:"N"}, Transfer{StopID:"R30", Route:"Q"}, Transfer{StopID:"R30", Route:"R"}, Transfer{StopID:"R30", Route:"W"}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"N"}, Transfer{StopID:"R32", Route:"R"}, Transfer{StopID:"R32", Route:"W"}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F23", Route:"F"}, Transfer{StopID:"F23", Route:"G"}, Transfer{StopID:"R33", Route:"N"}, Transfer{StopID:"R33", Route:"R"}, Transfer{StopID:"R33", Route:"W"}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"N"}, Transfer{StopID:"R34", Route:"R"}, Transfer{StopID:"R34", Route:"W"}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"N"}, Transfer{StopID:"R35", Route:"R"}, Transfer{StopID:"R35", Route:"W"}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"N"}, Transfer{StopID:"R36", Route:"R"}, Transfer{StopID:"R36", Route:"W"}}}, Stop{ID:"B12", MTAName:"9 Av", DisplayName:"9th Avenue", PhoneticName:"9th Avenue", Synonyms:[]string{"9th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B13", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"B14", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B15", MTAName:"55 St", DisplayName:"55th Street", PhoneticName:"55th Street", Synonyms:[]string{"55th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B16", MTAName:"62 St", DisplayName:"62nd Street", PhoneticName:"62nd Street", Synonyms:[]string{"62nd Street"}, Transfers:[]Transfer{Transfer{StopID:"N04", Route:"N"}, Transfer{StopID:"N04", Route:"W"}}}, Stop{ID:"B17", MTAName:"71 St", DisplayName:"71st Street", PhoneticName:"71st Street", Synonyms:[]string{"71st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B18", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Synonyms:[]string{"79th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B19", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B20", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B21", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"B22", MTAName:"25 Av", DisplayName:"25th Avenue", PhoneticName:"25th Avenue", Synonyms:[]string{"25th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B23", MTAName:"Bay 50 St", DisplayName:"Bay 50th Street", PhoneticName:"Bay 50th Street", Synonyms:[]string{"Bay 50th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"F"}, Transfer{StopID:"D43", Route:"N"}, Transfer{StopID:"D43", Route:"Q"}}}}}, "E":Route{Name:"E", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"J"}, Transfer{StopID:"G05", Route:"Z"}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"J"}, Transfer{StopID:"G06", Route:"Z"}}}, Stop{ID:"G07", MTAName:"Jamaica - Van Wyck", DisplayName:"Jamaica - Van Wyck", PhoneticName:"Jamaica, Van Wyck", Synonyms:[]string{"Jamaica", "Van Wyck", "Van Wyck, Jamaica", "Jamaica, Van Wyck"}, Transfers:[]Transfer(nil)}, Stop{ID:"F05", MTAName:"Briarwood", DisplayName:"Briarwood", PhoneticName:"Briarwood", Synonyms:[]string{"Briarwood"}, Transfers:[]Transfer{Transfer{StopID:"F05", Route:"F"}}}, Stop{ID:"F06", MTAName:"Kew Gardens - Union Tpke", DisplayName:"Kew Gardens - Union Tpke", PhoneticName:"Kew Gardens, Union Tpke", Synonyms:[]string{"Kew Gardens", "Union Tpke", "Union Tpke, Kew Gardens", "Kew Gardens, Union Tpke"}, Transfers:[]Transfer{Transfer{StopID:"F06", Route:"F"}}}, Stop{ID:"F07", MTAName:"75 Av", DisplayName:"75th Avenue", PhoneticName:"75th Avenue", Synonyms:[]string{"75th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F07", Route:"F"}}}, Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"F"}, Transfer{StopID:"G08", Route:"M"}, Transfer{StopID:"G08", Route:"R"}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"M"}, Transfer{StopID:"G09", Route:"R"}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"M"}, Transfer{StopID:"G10", Route:"R"}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"M"}, Transfer{StopID:"G11", Route:"R"}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"M"}, Transfer{StopID:"G12", Route:"R"}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"M"}, Transfer{StopID:"G13", Route:"R"}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7"}, Transfer{StopID:"G14", Route:"F"}, Transfer{StopID:"G14", Route:"M"}, Transfer{StopID:"G14", Route:"R"}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"M"}, Transfer{StopID:"G15", Route:"R"}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"M"}, Transfer{StopID:"G16", Route:"R"}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"M"}, Transfer{StopID:"G18", Route:"R"}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"M"}, Transfer{StopID:"G19", Route:"R"}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"M"}, Transfer{StopID:"G20", Route:"R"}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"M"}, Transfer{StopID:"G21", Route:"R"}}}, Stop{ID:"F09", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7"}, Transfer{StopID:"G22", Route:"G"}, Transfer{StopID:"F09", Route:"M"}}}, Stop{ID:"F11", MTAName:"Lexington Av/53 St", DisplayName:"Lexington Avenue, 53rd Street", PhoneticName:"Lexington Avenue, 53rd Street", Synonyms:[]string{"Lexington Avenue", "53rd Street", "53rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4"}, Transfer{StopID:"630", Route:"6"}, Transfer{StopID:"630", Route:"6X"}, Transfer{StopID:"F11", Route:"M"}}}, Stop{ID:"F12", MTAName:"5 Av/53 St", DisplayName:"5th Avenue, 53rd Street", PhoneticName:"5th Avenue, 53rd Street", Synonyms:[]string{"5th Avenue", "53rd Street", "53rd Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F12", Route:"M"}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"B"}, Transfer{StopID:"D14", Route:"D"}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"A"}, Transfer{StopID:"A25", Route:"C"}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"A"}, Transfer{StopID:"A28", Route:"C"}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"A"}, Transfer{StopID:"A30", Route:"C"}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A"}, Transfer{StopID:"A31", Route:"C"}, Transfer{StopID:"L01", Route:"L"}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"D20", Route:"F"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"A"}, Transfer{StopID:"A33", Route:"C"}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"A"}, Transfer{StopID:"A34", Route:"C"}}}, Stop{ID:"E01", MTAName:"World Trade Center", DisplayName:"World Trade Center", PhoneticName:"World Trade Center", Synonyms:[]string{"World Trade Center"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}}}, "F":Route{Name:"F", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"F01", MTAName:"Jamaica - 179 St", DisplayName:"Jamaica - 179th Street", PhoneticName:"Jamaica, 179th Street", Synonyms:[]string{"Jamaica", "179th Street", "179th Street, Jamaica", "Jamaica, 179th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"F02", MTAName:"169 St", DisplayName:"169th Street", PhoneticName:"169th Street", Synonyms:[]string{"169th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"F03", MTAName:"Parsons Blvd", DisplayName:"Parsons Boulevard", PhoneticName:"Parsons Boulevard", Synonyms:[]string{"Parsons Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"F04", MTAName:"Sutphin Blvd", DisplayName:"Sutphin Boulevard", PhoneticName:"Sutphin Boulevard", Synonyms:[]string{"Sutphin Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"F05", MTAName:"Briarwood", DisplayName:"Briarwood", PhoneticName:"Briarwood", Synonyms:[]string{"Briarwood"}, Transfers:[]Transfer{Transfer{StopID:"F05", Route:"E"}}}, Stop{ID:"F06", MTAName:"Kew Gardens - Union Tpke", DisplayName:"Kew Gardens - Union Tpke", PhoneticName:"Kew Gardens, Union Tpke", Synonyms:[]string{"Kew Gardens", "Union Tpke", "Union Tpke, Kew Gardens", "Kew Gardens, Union Tpke"}, Transfers:[]Transfer{Transfer{StopID:"F06", Route:"E"}}}, Stop{ID:"F07", MTAName:"75 Av", DisplayName:"75th Avenue", PhoneticName:"75th Avenue", Synonyms:[]string{"75th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F07", Route:"E"}}}, Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E"}, Transfer{StopID:"G08", Route:"M"}, Transfer{StopID:"G08", Route:"R"}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7"}, Transfer{StopID:"G14", Route:"E"}, Transfer{StopID:"G14", Route:"M"}, Transfer{StopID:"G14", Route:"R"}}}, Stop{ID:"B04", MTAName:"21 St - Queensbridge", DisplayName:"21st Street, Queensbridge", PhoneticName:"21st Street, Queensbridge", Synonyms:[]string{"21st Street", "Queensbridge", "Queensbridge, 21st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B06", MTAName:"Roosevelt Island", DisplayName:"Roosevelt Island", PhoneticName:"Roosevelt Island", Synonyms:[]string{"Roosevelt Island"}, Transfers:[]Transfer(nil)}, Stop{ID:"B08", MTAName:"Lexington Av/63 St", DisplayName:"Lexington Avenue, 63rd Street", PhoneticName:"Lexington Avenue, 63rd Street", Synonyms:[]string{"Lexington Avenue", "63rd Street", "63rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"B10", MTAName:"57 St", DisplayName:"57th Street", PhoneticName:"57th Street", Synonyms:[]string{"57th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B"}, Transfer{StopID:"D15", Route:"D"}, Transfer{StopID:"D15", Route:"M"}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7"}, Transfer{StopID:"D16", Route:"B"}, Transfer{StopID:"D16", Route:"D"}, Transfer{StopID:"D16", Route:"M"}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"D18", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"D18", Route:"M"}}}, Stop{ID:"D19", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1"}, Transfer{StopID:"132", Route:"2"}, Transfer{StopID:"132", Route:"3"}, Transfer{StopID:"L02", Route:"L"}, Transfer{StopID:"D19", Route:"M"}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"M"}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"M"}}}, Stop{ID:"F14", MTAName:"2 Av", DisplayName:"2nd Avenue", PhoneticName:"2nd Avenue", Synonyms:[]string{"2nd Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F15", MTAName:"Delancey St - Essex St", DisplayName:"Delancey Street, Essex Street", PhoneticName:"Delancey Street, Essex Street", Synonyms:[]string{"Delancey Street", "Essex Street", "Essex Street, Delancey Street"}, Transfers:[]Transfer{Transfer{StopID:"M18", Route:"J"}, Transfer{StopID:"M18", Route:"M"}, Transfer{StopID:"M18", Route:"Z"}}}, Stop{ID:"F16", MTAName:"East Broadway", DisplayName:"East Broadway", PhoneticName:"East Broadway", Synonyms:[]string{"East Broadway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F18", MTAName:"York St", DisplayName:"York Street", PhoneticName:"York Street", Synonyms:[]string{"York Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A"}, Transfer{StopID:"A41", Route:"C"}, Transfer{StopID:"R29", Route:"N"}, Transfer{StopID:"R29", Route:"R"}, Transfer{StopID:"R29", Route:"W"}}}, Stop{ID:"F20", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"F20", Route:"G"}}}, Stop{ID:"F21", MTAName:"Carroll St", DisplayName:"Carroll Street", PhoneticName:"Carroll Street", Synonyms:[]string{"Carroll Street"}, Transfers:[]Transfer{Transfer{StopID:"F21", Route:"G"}}}, Stop{ID:"F22", MTAName:"Smith - 9 Sts", DisplayName:"Smith - 9th Streets", PhoneticName:"Smith, 9th Streets", Synonyms:[]string{"Smith", "9th Streets", "9th Streets, Smith", "Smith, 9th Streets"}, Transfers:[]Transfer{Transfer{StopID:"F22", Route:"G"}}}, Stop{ID:"F23", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D"}, Transfer{StopID:"F23", Route:"G"}, Transfer{StopID:"R33", Route:"N"}, Transfer{StopID:"R33", Route:"R"}, Transfer{StopID:"R33", Route:"W"}}}, Stop{ID:"F24", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F24", Route:"G"}}}, Stop{ID:"F25", MTAName:"15 St - Prospect Park", DisplayName:"15th Street, Prospect Park", PhoneticName:"15th Street, Prospect Park", Synonyms:[]string{"15th Street", "Prospect Park", "Prospect Park, 15th Street"}, Transfers:[]Transfer{Transfer{StopID:"F25", Route:"G"}}}, Stop{ID:"F26", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"F26", Route:"G"}}}, Stop{ID:"F27", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F27", Route:"G"}}}, Stop{ID:"F29", MTAName:"Ditmas Av", DisplayName:"Ditmas Avenue", PhoneticName:"Ditmas Avenue", Synonyms:[]string{"Ditmas Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F30", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F31", MTAName:"Avenue I", DisplayName:"Avenue I", PhoneticName:"Avenue I", Synonyms:[]string{"Avenue I"}, Transfers:[]Transfer(nil)}, Stop{ID:"F32", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F33", MTAName:"Avenue N", DisplayName:"Avenue N", PhoneticName:"Avenue N", Synonyms:[]string{"Avenue N"}, Transfers:[]Transfer(nil)}, Stop{ID:"F34", MTAName:"Avenue P", DisplayName:"Avenue P", PhoneticName:"Avenue P", Synonyms:[]string{"Avenue P"}, Transfers:[]Transfer(nil)}, Stop{ID:"F35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F36", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer(nil)}, Stop{ID:"F38", MTAName:"Avenue X", DisplayName:"Avenue X", PhoneticName:"Avenue X", Synonyms:[]string{"Avenue X"}, Transfers:[]Transfer(nil)}, Stop{ID:"F39", MTAName:"Neptune Av", DisplayName:"Neptune Avenue", PhoneticName:"Neptune Avenue", Synonyms:[]string{"Neptune Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"D42", MTAName:"W 8 St - NY Aquarium", DisplayName:"West 8th Street, NY Aquarium", PhoneticName:"West 8th Street, NY Aquarium", Synonyms:[]string{"West 8th Street", "NY Aquarium", "NY Aquarium, West 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"D42", Route:"Q"}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D"}, Transfer{StopID:"D43", Route:"N"}, Transfer{StopID:"D43", Route:"Q"}}}}}, "G":Route{Name:"G", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G22", MTAName:"Court Sq - 23 St", DisplayName:"Court Square - 23rd Street", PhoneticName:"Court Square, 23rd Street", Synonyms:[]string{"Court Square", "23rd Street", "23rd Street, Court Square", "Court Square, 23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7"}, Transfer{StopID:"F09", Route:"E"}, Transfer{StopID:"F09", Route:"M"}}}, Stop{ID:"G24", MTAName:"21 St", DisplayName:"21st Street", PhoneticName:"21st Street", Synonyms:[]string{"21st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"G26", MTAName:"Greenpoint Av", DisplayName:"Greenpoint Avenue", PhoneticName:"Greenpoint Avenue", Synonyms:[]string{"Greenpoint Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G28", MTAName:"Nassau Av", DisplayName:"Nassau Avenue", PhoneticName:"Nassau Avenue", Synonyms:[]string{"Nassau Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G29", MTAName:"Metropolitan Av", DisplayName:"Metropolitan Avenue", PhoneticName:"Metropolitan Avenue", Synonyms:[]string{"Metropolitan Avenue"}, Transfers:[]Transfer{Transfer{StopID:"L10", Route:"L"}}}, Stop{ID:"G30", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Synonyms:[]string{"Broadway"}, Transfers:[]Transfer(nil)}, Stop{ID:"G31", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G32", MTAName:"Myrtle - Willoughby Avs", DisplayName:"Myrtle - Willoughby Avenues", PhoneticName:"Myrtle, Willoughby Avenues", Synonyms:[]string{"Myrtle", "Willoughby Avenues", "Willoughby Avenues, Myrtle", "Myrtle, Willoughby Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G33", MTAName:"Bedford - Nostrand Avs", DisplayName:"Bedford - Nostrand Avenues", PhoneticName:"Bedford, Nostrand Avenues", Synonyms:[]string{"Bedford", "Nostrand Avenues", "Nostrand Avenues, Bedford", "Bedford, Nostrand Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G34", MTAName:"Classon Av", DisplayName:"Classon Avenue", PhoneticName:"Classon Avenue", Synonyms:[]string{"Classon Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G35", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G36", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"A"}, Transfer{StopID:"A42", Route:"C"}}}, Stop{ID:"F20", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"F20", Route:"F"}}}, Stop{ID:"F21", MTAName:"Carroll St", DisplayName:"Carroll Street", PhoneticName:"Carroll Street", Synonyms:[]string{"Carroll Street"}, Transfers:[]Transfer{Transfer{StopID:"F21", Route:"F"}}}, Stop{ID:"F22", MTAName:"Smith - 9 Sts", DisplayName:"Smith - 9th Streets", PhoneticName:"Smith, 9th Streets", Synonyms:[]string{"Smith", "9th Streets", "9th Streets, Smith", "Smith, 9th Streets"}, Transfers:[]Transfer{Transfer{StopID:"F22", Route:"F"}}}, Stop{ID:"F23", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D"}, Transfer{StopID:"F23", Route:"F"}, Transfer{StopID:"R33", Route:"N"}, Transfer{StopID:"R33", Route:"R"}, Transfer{StopID:"R33", Route:"W"}}}, Stop{ID:"F24", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F24", Route:"F"}}}, Stop{ID:"F25", MTAName:"15 St - Prospect Park", DisplayName:"15th Street, Prospect Park", PhoneticName:"15th Street, Prospect Park", Synonyms:[]string{"15th Street", "Prospect Park", "Prospect Park, 15th Street"}, Transfers:[]Transfer{Transfer{StopID:"F25", Route:"F"}}}, Stop{ID:"F26", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"F26", Route:"F"}}}, Stop{ID:"F27", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F27", Route:"F"}}}}}, "J":Route{Name:"J", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"E"}, Transfer{StopID:"G05", Route:"Z"}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"E"}, Transfer{StopID:"G06", Route:"Z"}}}, Stop{ID:"J12", MTAName:"121 St", DisplayName:"121st Street", PhoneticName:"121st Street", Synonyms:[]string{"121st Street"}, Transfers:[]Transfer{Transfer{StopID:"J12", Route:"Z"}}}, Stop{ID:"J13", MTAName:"111 St", DisplayName:"111st Street", PhoneticName:"111st Street", Synonyms:[]string{"111st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J14", MTAName:"104 St", DisplayName:"104th Street", PhoneticName:"104th Street", Synonyms:[]string{"104th Street"}, Transfers:[]Transfer{Transfer{StopID:"J14", Route:"Z"}}}, Stop{ID:"J15", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"J15", Route:"Z"}}}, Stop{ID:"J16", MTAName:"85 St - Forest Pkwy", DisplayName:"85th Street, Forest Parkway", PhoneticName:"85th Street, Forest Parkway", Synonyms:[]string{"85th Street", "Forest Parkway", "Forest Parkway, 85th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J17", MTAName:"75 St - Elder Ln", DisplayName:"75th Street, Elder Ln", PhoneticName:"75th Street, Elder Ln", Synonyms:[]string{"75th Street", "Elder Ln", "Elder Ln, 75th Street"}, Transfers:[]Transfer{Transfer{StopID:"J17", Route:"Z"}}}, Stop{ID:"J19", MTAName:"Cypress Hills", DisplayName:"Cypress Hills", PhoneticName:"Cypress Hills", Synonyms:[]string{"Cypress Hills"}, Transfers:[]Transfer(nil)}, Stop{ID:"J20", MTAName:"Crescent St", DisplayName:"Crescent Street", PhoneticName:"Crescent Street", Synonyms:[]string{"Crescent Street"}, Transfers:[]Transfer{Transfer{StopID:"J20", Route:"Z"}}}, Stop{ID:"J21", MTAName:"Norwood Av", DisplayName:"Norwood Avenue", PhoneticName:"Norwood Avenue", Synonyms:[]string{"Norwood Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J21", Route:"Z"}}}, Stop{ID:"J22", MTAName:"Cleveland St", DisplayName:"Cleveland Street", PhoneticName:"Cleveland Street", Synonyms:[]string{"Cleveland Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J23", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J23", Route:"Z"}}}, Stop{ID:"J24", MTAName:"Alabama Av", DisplayName:"Alabama Avenue", PhoneticName:"Alabama Avenue", Synonyms:[]string{"Alabama Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J24", Route:"Z"}}}, Stop{ID:"J27", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A"}, Transfer{StopID:"A51", Route:"C"}, Transfer{StopID:"L22", Route:"L"}, Transfer{StopID:"J27", Route:"Z"}}}, Stop{ID:"J28", MTAName:"Chauncey St", DisplayName:"Chauncey Street", PhoneticName:"Chauncey Street", Synonyms:[]string{"Chauncey Street"}, Transfers:[]Transfer{Transfer{StopID:"J28", Route:"Z"}}}, Stop{ID:"J29", MTAName:"Halsey St", DisplayName:"Halsey Street", PhoneticName:"Halsey Street", Synonyms:[]string{"Halsey Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J30", MTAName:"Gates Av", DisplayName:"Gates Avenue", PhoneticName:"Gates Avenue", Synonyms:[]string{"Gates Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J30", Route:"Z"}}}, Stop{ID:"J31", MTAName:"Kosciuszko St", DisplayName:"Kosciuszko Street", PhoneticName:"Kosciuszko Street", Synonyms:[]string{"Kosciuszko Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"M"}, Transfer{StopID:"M11", Route:"Z"}}}, Stop{ID:"M12", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M12", Route:"M"}}}, Stop{ID:"M13", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"M13", Route:"M"}}}, Stop{ID:"M14", MTAName:"Hewes St", DisplayName:"Hewes Street", PhoneticName:"Hewes Street", Synonyms:[]string{"Hewes Street"}, Transfers:[]Transfer{Transfer{StopID:"M14", Route:"M"}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"M"}, Transfer{StopID:"M16", Route:"Z"}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F"}, Transfer{StopID:"M18", Route:"M"}, Transfer{StopID:"M18", Route:"Z"}}}, Stop{ID:"M19", MTAName:"Bowery", DisplayName:"Bowery", PhoneticName:"Bowery", Synonyms:[]string{"Bowery"}, Transfers:[]Transfer{Transfer{StopID:"M19", Route:"Z"}}}, Stop{ID:"M20", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"M21", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"Z"}}}, Stop{ID:"M22", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"Z"}}}, Stop{ID:"M23", MTAName:"Broad St", DisplayName:"Broad Street", PhoneticName:"Broad Street", Synonyms:[]string{"Broad Street"}, Transfers:[]Transfer{Transfer{StopID:"M23", Route:"Z"}}}}}, "L":Route{Name:"L", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"L01", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A"}, Transfer{StopID:"A31", Route:"C"}, Transfer{StopID:"A31", Route:"E"}}}, Stop{ID:"L02", MTAName:"6 Av", DisplayName:"6th Avenue", PhoneticName:"6th Avenue", Synonyms:[]string{"6th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1"}, Transfer{StopID:"132", Route:"2"}, Transfer{StopID:"132", Route:"3"}, Transfer{StopID:"D19", Route:"F"}, Transfer{StopID:"D19", Route:"M"}}}, Stop{ID:"L03", MTAName:"Union Sq - 14 St", DisplayName:"Union Square - 14th Street", PhoneticName:"Union Square, 14th Street", Synonyms:[]string{"Union Square", "14th Street", "14th Street, Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"L05", MTAName:"3 Av", DisplayName:"3rd Avenue", PhoneticName:"3rd Avenue", Synonyms:[]string{"3rd Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L06", MTAName:"1 Av", DisplayName:"1st Avenue", PhoneticName:"1st Avenue", Synonyms:[]string{"1st Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L08", MTAName:"Bedford Av", DisplayName:"Bedford Avenue", PhoneticName:"Bedford Avenue", Synonyms:[]string{"Bedford Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L10", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"G29", Route:"G"}}}, Stop{ID:"L11", MTAName:"Graham Av", DisplayName:"Graham Avenue", PhoneticName:"Graham Avenue", Synonyms:[]string{"Graham Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L12", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L13", MTAName:"Montrose Av", DisplayName:"Montrose Avenue", PhoneticName:"Montrose Avenue", Synonyms:[]string{"Montrose Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L14", MTAName:"Morgan Av", DisplayName:"Morgan Avenue", PhoneticName:"Morgan Avenue", Synonyms:[]string{"Morgan Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L15", MTAName:"Jefferson St", DisplayName:"Jefferson Street", PhoneticName:"Jefferson Street", Synonyms:[]string{"Jefferson Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L16", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L17", MTAName:"Myrtle - Wyckoff Avs", DisplayName:"Myrtle - Wyckoff Avenues", PhoneticName:"Myrtle, Wyckoff Avenues", Synonyms:[]string{"Myrtle", "Wyckoff Avenues", "Wyckoff Avenues, Myrtle", "Myrtle, Wyckoff Avenues"}, Transfers:[]Transfer{Transfer{StopID:"M08", Route:"M"}}}, Stop{ID:"L19", MTAName:"Halsey St", DisplayName:"Halsey Street", PhoneticName:"Halsey Street", Synonyms:[]string{"Halsey Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L20", MTAName:"Wilson Av", DisplayName:"Wilson Avenue", PhoneticName:"Wilson Avenue", Synonyms:[]string{"Wilson Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L21", MTAName:"Bushwick Av - Aberdeen St", DisplayName:"Bushwick Avenue, Aberdeen Street", PhoneticName:"Bushwick Avenue, Aberdeen Street", Synonyms:[]string{"Bushwick Avenue", "Aberdeen Street", "Aberdeen Street, Bushwick Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L22", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A"}, Transfer{StopID:"A51", Route:"C"}, Transfer{StopID:"J27", Route:"J"}, Transfer{StopID:"J27", Route:"Z"}}}, Stop{ID:"L24", MTAName:"Atlantic Av", DisplayName:"Atlantic Avenue", PhoneticName:"Atlantic Avenue", Synonyms:[]string{"Atlantic Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L25", MTAName:"Sutter Av", DisplayName:"Sutter Avenue", PhoneticName:"Sutter Avenue", Synonyms:[]string{"Sutter Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L26", MTAName:"Livonia Av", DisplayName:"Livonia Avenue", PhoneticName:"Livonia Avenue", Synonyms:[]string{"Livonia Avenue"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3"}, Transfer{StopID:"254", Route:"4"}, Transfer{StopID:"254", Route:"5"}}}, Stop{ID:"L27", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L28", MTAName:"E 105 St", DisplayName:"East 105th Street", PhoneticName:"East 105th Street", Synonyms:[]string{"East 105th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L29", MTAName:"Canarsie - Rockaway Pkwy", DisplayName:"Canarsie - Rockaway Parkway", PhoneticName:"Canarsie, Rockaway Parkway", Synonyms:[]string{"Canarsie", "Rockaway Parkway", "Rockaway Parkway, Canarsie", "Canarsie, Rockaway Parkway"}, Transfers:[]Transfer(nil)}}}, "M":Route{Name:"M", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E"}, Transfer{StopID:"G08", Route:"F"}, Transfer{StopID:"G08", Route:"R"}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"E"}, Transfer{StopID:"G09", Route:"R"}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"E"}, Transfer{StopID:"G10", Route:"R"}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"E"}, Transfer{StopID:"G11", Route:"R"}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"E"}, Transfer{StopID:"G12", Route:"R"}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"E"}, Transfer{StopID:"G13", Route:"R"}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7"}, Transfer{StopID:"G14", Route:"E"}, Transfer{StopID:"G14", Route:"F"}, Transfer{StopID:"G14", Route:"R"}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"E"}, Transfer{StopID:"G15", Route:"R"}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"E"}, Transfer{StopID:"G16", Route:"R"}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"E"}, Transfer{StopID:"G18", Route:"R"}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"E"}, Transfer{StopID:"G19", Route:"R"}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"E"}, Transfer{StopID:"G20", Route:"R"}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"E"}, Transfer{StopID:"G21", Route:"R"}}}, Stop{ID:"F09", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7"}, Transfer{StopID:"F09", Route:"E"}, Transfer{StopID:"G22", Route:"G"}}}, Stop{ID:"F11", MTAName:"Lexington Av/53 St", DisplayName:"Lexington Avenue, 53rd Street", PhoneticName:"Lexington Avenue, 53rd Street", Synonyms:[]string{"Lexington Avenue", "53rd Street", "53rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4"}, Transfer{StopID:"630", Route:"6"}, Transfer{StopID:"630", Route:"6X"}, Transfer{StopID:"F11", Route:"E"}}}, Stop{ID:"F12", MTAName:"5 Av/53 St", DisplayName:"5th Avenue, 53rd Street", PhoneticName:"5th Avenue, 53rd Street", Synonyms:[]string{"5th Avenue", "53rd Street", "53rd Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F12", Route:"E"}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B"}, Transfer{StopID:"D15", Route:"D"}, Transfer{StopID:"D15", Route:"F"}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7"}, Transfer{StopID:"D16", Route:"B"}, Transfer{StopID:"D16", Route:"D"}, Transfer{StopID:"D16", Route:"F"}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"D18", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"D18", Route:"F"}}}, Stop{ID:"D19", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1"}, Transfer{StopID:"132", Route:"2"}, Transfer{StopID:"132", Route:"3"}, Transfer{StopID:"D19", Route:"F"}, Transfer{StopID:"L02", Route:"L"}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A"}, Transfer{StopID:"D20", Route:"B"}, Transfer{StopID:"A32", Route:"C"}, Transfer{StopID:"D20", Route:"D"}, Transfer{StopID:"A32", Route:"E"}, Transfer{StopID:"D20", Route:"F"}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4"}, Transfer{StopID:"637", Route:"6"}, Transfer{StopID:"637", Route:"6X"}, Transfer{StopID:"D21", Route:"B"}, Transfer{StopID:"D21", Route:"D"}, Transfer{StopID:"D21", Route:"F"}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F"}, Transfer{StopID:"M18", Route:"J"}, Transfer{StopID:"M18", Route:"Z"}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"J"}, Transfer{StopID:"M16", Route:"Z"}}}, Stop{ID:"M14", MTAName:"Hewes St", DisplayName:"Hewes Street", PhoneticName:"Hewes Street", Synonyms:[]string{"Hewes Street"}, Transfers:[]Transfer{Transfer{StopID:"M14", Route:"J"}}}, Stop{ID:"M13", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"M13", Route:"J"}}}, Stop{ID:"M12", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M12", Route:"J"}}}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"J"}, Transfer{StopID:"M11", Route:"Z"}}}, Stop{ID:"M10", MTAName:"Central Av", DisplayName:"Central Avenue", PhoneticName:"Central Avenue", Synonyms:[]string{"Central Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M09", MTAName:"Knickerbocker Av", DisplayName:"Knickerbocker Avenue", PhoneticName:"Knickerbocker Avenue", Synonyms:[]string{"Knickerbocker Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M08", MTAName:"Myrtle - Wyckoff Avs", DisplayName:"Myrtle - Wyckoff Avenues", PhoneticName:"Myrtle, Wyckoff Avenues", Synonyms:[]string{"Myrtle", "Wyckoff Avenues", "Wyckoff Avenues, Myrtle", "Myrtle, Wyckoff Avenues"}, Transfers:[]Transfer{Transfer{StopID:"L17", Route:"L"}}}, Stop{ID:"M06", MTAName:"Seneca Av", DisplayName:"Seneca Avenue", PhoneticName:"Seneca Avenue", Synonyms:[]string{"Seneca Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M05", MTAName:"Forest Av", DisplayName:"Forest Avenue", PhoneticName:"Forest Avenue", Synonyms:[]string{"Forest Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M04", MTAName:"Fresh Pond Rd", DisplayName:"Fresh Pond Road", PhoneticName:"Fresh Pond Road", Synonyms:[]string{"Fresh Pond Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"M01", MTAName:"Middle Village - Metropolitan Av", DisplayName:"Middle Village - Metropolitan Avenue", PhoneticName:"Middle Village, Metropolitan Avenue", Synonyms:[]string{"Middle Village", "Metropolitan Avenue", "Metropolitan Avenue, Middle Village", "Middle Village, Metropolitan Avenue"}, Transfers:[]Transfer(nil)}}}, "N":Route{Name:"N", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"R01", MTAName:"Astoria - Ditmars Blvd", DisplayName:"Astoria - Ditmars Boulevard", PhoneticName:"Astoria, Ditmars Boulevard", Synonyms:[]string{"Astoria", "Ditmars Boulevard", "Ditmars Boulevard, Astoria", "Astoria, Ditmars Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R01", Route:"W"}}}, Stop{ID:"R03", MTAName:"Astoria Blvd", DisplayName:"Astoria Boulevard", PhoneticName:"Astoria Boulevard", Synonyms:[]string{"Astoria Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R03", Route:"W"}}}, Stop{ID:"R04", MTAName:"30 Av", DisplayName:"30th Avenue", PhoneticName:"30th Avenue", Synonyms:[]string{"30th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R04", Route:"W"}}}, Stop{ID:"R05", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Synonyms:[]string{"Broadway"}, Transfers:[]Transfer{Transfer{StopID:"R05", Route:"W"}}}, Stop{ID:"R06", MTAName:"36 Av", DisplayName:"36th Avenue", PhoneticName:"36th Avenue", Synonyms:[]string{"36th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R06", Route:"W"}}}, Stop{ID:"R08", MTAName:"39 Av - Dutch Kills", DisplayName:"39th Avenue, Dutch Kills", PhoneticName:"39th Avenue, Dutch Kills", Synonyms:[]string{"39th Avenue", "Dutch Kills", "Dutch Kills, 39th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R08", Route:"W"}}}, Stop{ID:"R09", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"718", Route:"7"}, Transfer{StopID:"R09", Route:"W"}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"R"}, Transfer{StopID:"R13", Route:"W"}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"Q"}, Transfer{StopID:"R14", Route:"R"}, Transfer{StopID:"R14", Route:"W"}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"Q"}, Transfer{StopID:"R15", Route:"R"}, Transfer{StopID:"R15", Route:"W"}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"Q"}, Transfer{StopID:"R18", Route:"R"}, Transfer{StopID:"R18", Route:"W"}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"Q"}, Transfer{StopID:"R19", Route:"R"}, Transfer{StopID:"R19", Route:"W"}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"Q"}, Transfer{StopID:"R21", Route:"R"}, Transfer{StopID:"R21", Route:"W"}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"Q"}, Transfer{StopID:"R22", Route:"R"}, Transfer{StopID:"R22", Route:"W"}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"R"}, Transfer{StopID:"R24", Route:"W"}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"R"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"R"}, Transfer{StopID:"R26", Route:"W"}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"R"}, Transfer{StopID:"R27", Route:"W"}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"R"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A"}, Transfer{StopID:"A41", Route:"C"}, Transfer{StopID:"A41", Route:"F"}, Transfer{StopID:"R29", Route:"R"}, Transfer{StopID:"R29", Route:"W"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B"}, Transfer{StopID:"R30", Route:"D"}, Transfer{StopID:"R30", Route:"Q"}, Transfer{StopID:"R30", Route:"R"}, Transfer{StopID:"R30", Route:"W"}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D"}, Transfer{StopID:"R32", Route:"R"}, Transfer{StopID:"R32", Route:"W"}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D"}, Transfer{StopID:"F23", Route:"F"}, Transfer{StopID:"F23", Route:"G"}, Transfer{StopID:"R33", Route:"R"}, Transfer{StopID:"R33", Route:"W"}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D"}, Transfer{StopID:"R34", Route:"R"}, Transfer{StopID:"R34", Route:"W"}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D"}, Transfer{StopID:"R35", Route:"R"}, Transfer{StopID:"R35", Route:"W"}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D"}, Transfer{StopID:"R36", Route:"R"}, Transfer{StopID:"R36", Route:"W"}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"R"}, Transfer{StopID:"R39", Route:"W"}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"R"}, Transfer{StopID:"R40", Route:"W"}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"R"}, Transfer{StopID:"R41", Route:"W"}}}, Stop{ID:"N02", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N02", Route:"W"}}}, Stop{ID:"N03", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N03", Route:"W"}}}, Stop{ID:"N04", MTAName:"New Utrecht Av", DisplayName:"New Utrecht Avenue", PhoneticName:"New Utrecht Avenue", Synonyms:[]string{"New Utrecht Avenue"}, Transfers:[]Transfer{Transfer{StopID:"B16", Route:"D"}, Transfer{StopID:"N04", Route:"W"}}}, Stop{ID:"N05", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N05", Route:"W"}}}, Stop{ID:"N06", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N06", Route:"W"}}}, Stop{ID:"N07", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N07", Route:"W"}}}, Stop{ID:"N08", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"N08", Route:"W"}}}, Stop{ID:"N09", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer{Transfer{StopID:"N09", Route:"W"}}}, Stop{ID:"N10", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"N10", Route:"W"}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D"}, Transfer{StopID:"D43", Route:"F"}, Transfer{StopID:"D43", Route:"Q"}}}}}, "Q":Route{Name:"Q", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"Q05", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Synonyms:[]string{"96th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"Q04", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"Q03", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B08", MTAName:"Lexington Av/63 St", DisplayName:"Lexington Avenue, 63rd Street", PhoneticName:"Lexington Avenue, 63rd Street", Synonyms:[]string{"Lexington Avenue", "63rd Street", "63rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"R11", Route:"R"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N"}, Transfer{StopID:"R14", Route:"R"}, Transfer{StopID:"R14", Route:"W"}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N"}, Transfer{StopID:"R15", Route:"R"}, Transfer{StopID:"R15", Route:"W"}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"R"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"R"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N"}, Transfer{StopID:"R18", Route:"R"}, Transfer{StopID:"R18", Route:"W"}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N"}, Transfer{StopID:"R19", Route:"R"}, Transfer{StopID:"R19", Route:"W"}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"R"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N"}, Transfer{StopID:"R21", Route:"R"}, Transfer{StopID:"R21", Route:"W"}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N"}, Transfer{StopID:"R22", Route:"R"}, Transfer{StopID:"R22", Route:"W"}}}, Stop{ID:"Q01", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B"}, Transfer{StopID:"R30", Route:"D"}, Transfer{StopID:"R30", Route:"N"}, Transfer{StopID:"R30", Route:"R"}, Transfer{StopID:"R30", Route:"W"}}}, Stop{ID:"D24", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"R31", Route:"R"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"D25", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D25", Route:"B"}}}, Stop{ID:"D26", MTAName:"Prospect Park", DisplayName:"Prospect Park", PhoneticName:"Prospect Park", Synonyms:[]string{"Prospect Park"}, Transfers:[]Transfer{Transfer{StopID:"D26", Route:"B"}}}, Stop{ID:"D27", MTAName:"Parkside Av", DisplayName:"Parkside Avenue", PhoneticName:"Parkside Avenue", Synonyms:[]string{"Parkside Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"D28", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D28", Route:"B"}}}, Stop{ID:"D29", MTAName:"Beverley Rd", DisplayName:"Beverley Road", PhoneticName:"Beverley Road", Synonyms:[]string{"Beverley Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D30", MTAName:"Cortelyou Rd", DisplayName:"Cortelyou Road", PhoneticName:"Cortelyou Road", Synonyms:[]string{"Cortelyou Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D31", MTAName:"Newkirk Plaza", DisplayName:"Newkirk Plaza", PhoneticName:"Newkirk Plaza", Synonyms:[]string{"Newkirk Plaza"}, Transfers:[]Transfer{Transfer{StopID:"D31", Route:"B"}}}, Stop{ID:"D32", MTAName:"Avenue H", DisplayName:"Avenue H", PhoneticName:"Avenue H", Synonyms:[]string{"Avenue H"}, Transfers:[]Transfer(nil)}, Stop{ID:"D33", MTAName:"Avenue J", DisplayName:"Avenue J", PhoneticName:"Avenue J", Synonyms:[]string{"Avenue J"}, Transfers:[]Transfer(nil)}, Stop{ID:"D34", MTAName:"Avenue M", DisplayName:"Avenue M", PhoneticName:"Avenue M", Synonyms:[]string{"Avenue M"}, Transfers:[]Transfer(nil)}, Stop{ID:"D35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"D35", Route:"B"}}}, Stop{ID:"D37", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer(nil)}, Stop{ID:"D38", MTAName:"Neck Rd", DisplayName:"Neck Road", PhoneticName:"Neck Road", Synonyms:[]string{"Neck Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D39", MTAName:"Sheepshead Bay", DisplayName:"Sheepshead Bay", PhoneticName:"Sheepshead Bay", Synonyms:[]string{"Sheepshead Bay"}, Transfers:[]Transfer{Transfer{StopID:"D39", Route:"B"}}}, Stop{ID:"D40", MTAName:"Brighton Beach", DisplayName:"Brighton Beach", PhoneticName:"Brighton Beach", Synonyms:[]string{"Brighton Beach"}, Transfers:[]Transfer{Transfer{StopID:"D40", Route:"B"}}}, Stop{ID:"D41", MTAName:"Ocean Pkwy", DisplayName:"Ocean Parkway", PhoneticName:"Ocean Parkway", Synonyms:[]string{"Ocean Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"D42", MTAName:"W 8 St - NY Aquarium", DisplayName:"West 8th Street, NY Aquarium", PhoneticName:"West 8th Street, NY Aquarium", Synonyms:[]string{"West 8th Street", "NY Aquarium", "NY Aquarium, West 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"D42", Route:"F"}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D"}, Transfer{StopID:"D43", Route:"F"}, Transfer{StopID:"D43", Route:"N"}}}}}, "R":Route{Name:"R", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E"}, Transfer{StopID:"G08", Route:"F"}, Transfer{StopID:"G08", Route:"M"}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"E"}, Transfer{StopID:"G09", Route:"M"}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"E"}, Transfer{StopID:"G10", Route:"M"}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"E"}, Transfer{StopID:"G11", Route:"M"}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"E"}, Transfer{StopID:"G12", Route:"M"}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"E"}, Transfer{StopID:"G13", Route:"M"}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7"}, Transfer{StopID:"G14", Route:"E"}, Transfer{StopID:"G14", Route:"F"}, Transfer{StopID:"G14", Route:"M"}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"E"}, Transfer{StopID:"G15", Route:"M"}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"E"}, Transfer{StopID:"G16", Route:"M"}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"E"}, Transfer{StopID:"G18", Route:"M"}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"E"}, Transfer{StopID:"G19", Route:"M"}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"E"}, Transfer{StopID:"G20", Route:"M"}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"E"}, Transfer{StopID:"G21", Route:"M"}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"W"}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"N"}, Transfer{StopID:"R13", Route:"W"}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N"}, Transfer{StopID:"R14", Route:"Q"}, Transfer{StopID:"R14", Route:"W"}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N"}, Transfer{StopID:"R15", Route:"Q"}, Transfer{StopID:"R15", Route:"W"}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"W"}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"W"}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N"}, Transfer{StopID:"R18", Route:"Q"}, Transfer{StopID:"R18", Route:"W"}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N"}, Transfer{StopID:"R19", Route:"Q"}, Transfer{StopID:"R19", Route:"W"}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"W"}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N"}, Transfer{StopID:"R21", Route:"Q"}, Transfer{StopID:"R21", Route:"W"}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N"}, Transfer{StopID:"R22", Route:"Q"}, Transfer{StopID:"R22", Route:"W"}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"W"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"N"}, Transfer{StopID:"R24", Route:"W"}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"W"}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"N"}, Transfer{StopID:"R26", Route:"W"}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"N"}, Transfer{StopID:"R27", Route:"W"}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"W"}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A"}, Transfer{StopID:"A41", Route:"C"}, Transfer{StopID:"A41", Route:"F"}, Transfer{StopID:"R29", Route:"N"}, Transfer{StopID:"R29", Route:"W"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B"}, Transfer{StopID:"R30", Route:"D"}, Transfer{StopID:"R30", Route:"N"}, Transfer{StopID:"R30", Route:"Q"}, Transfer{StopID:"R30", Route:"W"}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"W"}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D"}, Transfer{StopID:"R32", Route:"N"}, Transfer{StopID:"R32", Route:"W"}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D"}, Transfer{StopID:"F23", Route:"F"}, Transfer{StopID:"F23", Route:"G"}, Transfer{StopID:"R33", Route:"N"}, Transfer{StopID:"R33", Route:"W"}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D"}, Transfer{StopID:"R34", Route:"N"}, Transfer{StopID:"R34", Route:"W"}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D"}, Transfer{StopID:"R35", Route:"N"}, Transfer{StopID:"R35", Route:"W"}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D"}, Transfer{StopID:"R36", Route:"N"}, Transfer{StopID:"R36", Route:"W"}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"N"}, Transfer{StopID:"R39", Route:"W"}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"N"}, Transfer{StopID:"R40", Route:"W"}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"N"}, Transfer{StopID:"R41", Route:"W"}}}, Stop{ID:"R42", MTAName:"Bay Ridge Av", DisplayName:"Bay Ridge Avenue", PhoneticName:"Bay Ridge Avenue", Synonyms:[]string{"Bay Ridge Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"R43", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Synonyms:[]string{"77th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"R44", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"R45", MTAName:"Bay Ridge - 95 St", DisplayName:"Bay Ridge - 95th Street", PhoneticName:"Bay Ridge, 95th Street", Synonyms:[]string{"Bay Ridge", "95th Street", "95th Street, Bay Ridge", "Bay Ridge, 95th Street"}, Transfers:[]Transfer(nil)}}}, "W":Route{Name:"W", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"R01", MTAName:"Astoria - Ditmars Blvd", DisplayName:"Astoria - Ditmars Boulevard", PhoneticName:"Astoria, Ditmars Boulevard", Synonyms:[]string{"Astoria", "Ditmars Boulevard", "Ditmars Boulevard, Astoria", "Astoria, Ditmars Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R01", Route:"N"}}}, Stop{ID:"R03", MTAName:"Astoria Blvd", DisplayName:"Astoria Boulevard", PhoneticName:"Astoria Boulevard", Synonyms:[]string{"Astoria Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R03", Route:"N"}}}, Stop{ID:"R04", MTAName:"30 Av", DisplayName:"30th Avenue", PhoneticName:"30th Avenue", Synonyms:[]string{"30th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R04", Route:"N"}}}, Stop{ID:"R05", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Synonyms:[]string{"Broadway"}, Transfers:[]Transfer{Transfer{StopID:"R05", Route:"N"}}}, Stop{ID:"R06", MTAName:"36 Av", DisplayName:"36th Avenue", PhoneticName:"36th Avenue", Synonyms:[]string{"36th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R06", Route:"N"}}}, Stop{ID:"R08", MTAName:"39 Av - Dutch Kills", DisplayName:"39th Avenue, Dutch Kills", PhoneticName:"39th Avenue, Dutch Kills", Synonyms:[]string{"39th Avenue", "Dutch Kills", "Dutch Kills, 39th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R08", Route:"N"}}}, Stop{ID:"R09", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"718", Route:"7"}, Transfer{StopID:"R09", Route:"N"}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4"}, Transfer{StopID:"629", Route:"5"}, Transfer{StopID:"629", Route:"5X"}, Transfer{StopID:"629", Route:"6"}, Transfer{StopID:"629", Route:"6X"}, Transfer{StopID:"B08", Route:"F"}, Transfer{StopID:"R11", Route:"N"}, Transfer{StopID:"B08", Route:"Q"}, Transfer{StopID:"R11", Route:"R"}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"N"}, Transfer{StopID:"R13", Route:"R"}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N"}, Transfer{StopID:"R14", Route:"Q"}, Transfer{StopID:"R14", Route:"R"}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N"}, Transfer{StopID:"R15", Route:"Q"}, Transfer{StopID:"R15", Route:"R"}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1"}, Transfer{StopID:"127", Route:"2"}, Transfer{StopID:"127", Route:"3"}, Transfer{StopID:"725", Route:"7"}, Transfer{StopID:"A27", Route:"A"}, Transfer{StopID:"A27", Route:"C"}, Transfer{StopID:"A27", Route:"E"}, Transfer{StopID:"R16", Route:"N"}, Transfer{StopID:"R16", Route:"Q"}, Transfer{StopID:"R16", Route:"R"}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B"}, Transfer{StopID:"D17", Route:"D"}, Transfer{StopID:"D17", Route:"F"}, Transfer{StopID:"D17", Route:"M"}, Transfer{StopID:"R17", Route:"N"}, Transfer{StopID:"R17", Route:"Q"}, Transfer{StopID:"R17", Route:"R"}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N"}, Transfer{StopID:"R18", Route:"Q"}, Transfer{StopID:"R18", Route:"R"}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N"}, Transfer{StopID:"R19", Route:"Q"}, Transfer{StopID:"R19", Route:"R"}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4"}, Transfer{StopID:"635", Route:"5"}, Transfer{StopID:"635", Route:"5X"}, Transfer{StopID:"635", Route:"6"}, Transfer{StopID:"635", Route:"6X"}, Transfer{StopID:"L03", Route:"L"}, Transfer{StopID:"R20", Route:"N"}, Transfer{StopID:"R20", Route:"Q"}, Transfer{StopID:"R20", Route:"R"}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N"}, Transfer{StopID:"R21", Route:"Q"}, Transfer{StopID:"R21", Route:"R"}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N"}, Transfer{StopID:"R22", Route:"Q"}, Transfer{StopID:"R22", Route:"R"}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"M20", Route:"Z"}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"N"}, Transfer{StopID:"R24", Route:"R"}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2"}, Transfer{StopID:"228", Route:"3"}, Transfer{StopID:"A36", Route:"A"}, Transfer{StopID:"A36", Route:"C"}, Transfer{StopID:"E01", Route:"E"}, Transfer{StopID:"R25", Route:"N"}, Transfer{StopID:"R25", Route:"R"}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"N"}, Transfer{StopID:"R26", Route:"R"}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"N"}, Transfer{StopID:"R27", Route:"R"}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2"}, Transfer{StopID:"232", Route:"3"}, Transfer{StopID:"423", Route:"4"}, Transfer{StopID:"423", Route:"5"}, Transfer{StopID:"423", Route:"5X"}, Transfer{StopID:"R28", Route:"N"}, Transfer{StopID:"R28", Route:"R"}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A"}, Transfer{StopID:"A41", Route:"C"}, Transfer{StopID:"A41", Route:"F"}, Transfer{StopID:"R29", Route:"N"}, Transfer{StopID:"R29", Route:"R"}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B"}, Transfer{StopID:"R30", Route:"D"}, Transfer{StopID:"R30", Route:"N"}, Transfer{StopID:"R30", Route:"Q"}, Transfer{StopID:"R30", Route:"R"}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2"}, Transfer{StopID:"235", Route:"3"}, Transfer{StopID:"235", Route:"4"}, Transfer{StopID:"235", Route:"5"}, Transfer{StopID:"235", Route:"5X"}, Transfer{StopID:"D24", Route:"B"}, Transfer{StopID:"R31", Route:"D"}, Transfer{StopID:"R31", Route:"N"}, Transfer{StopID:"D24", Route:"Q"}, Transfer{StopID:"R31", Route:"R"}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D"}, Transfer{StopID:"R32", Route:"N"}, Transfer{StopID:"R32", Route:"R"}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D"}, Transfer{StopID:"F23", Route:"F"}, Transfer{StopID:"F23", Route:"G"}, Transfer{StopID:"R33", Route:"N"}, Transfer{StopID:"R33", Route:"R"}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D"}, Transfer{StopID:"R34", Route:"N"}, Transfer{StopID:"R34", Route:"R"}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D"}, Transfer{StopID:"R35", Route:"N"}, Transfer{StopID:"R35", Route:"R"}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D"}, Transfer{StopID:"R36", Route:"N"}, Transfer{StopID:"R36", Route:"R"}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"N"}, Transfer{StopID:"R39", Route:"R"}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"N"}, Transfer{StopID:"R40", Route:"R"}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"N"}, Transfer{StopID:"R41", Route:"R"}}}, Stop{ID:"N02", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N02", Route:"N"}}}, Stop{ID:"N03", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N03", Route:"N"}}}, Stop{ID:"N04", MTAName:"New Utrecht Av", DisplayName:"New Utrecht Avenue", PhoneticName:"New Utrecht Avenue", Synonyms:[]string{"New Utrecht Avenue"}, Transfers:[]Transfer{Transfer{StopID:"B16", Route:"D"}, Transfer{StopID:"N04", Route:"N"}}}, Stop{ID:"N05", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N05", Route:"N"}}}, Stop{ID:"N06", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N06", Route:"N"}}}, Stop{ID:"N07", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N07", Route:"N"}}}, Stop{ID:"N08", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"N08", Route:"N"}}}, Stop{ID:"N09", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer{Transfer{StopID:"N09", Route:"N"}}}, Stop{ID:"N10", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"N10", Route:"N"}}}}}, "Z":Route{Name:"Z", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"E"}, Transfer{StopID:"G05", Route:"J"}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"E"}, Transfer{StopID:"G06", Route:"J"}}}, Stop{ID:"J12", MTAName:"121 St", DisplayName:"121st Street", PhoneticName:"121st Street", Synonyms:[]string{"121st Street"}, Transfers:[]Transfer{Transfer{StopID:"J12", Route:"J"}}}, Stop{ID:"J14", MTAName:"104 St", DisplayName:"104th Street", PhoneticName:"104th Street", Synonyms:[]string{"104th Street"}, Transfers:[]Transfer{Transfer{StopID:"J14", Route:"J"}}}, Stop{ID:"J15", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"J15", Route:"J"}}}, Stop{ID:"J17", MTAName:"75 St - Elder Ln", DisplayName:"75th Street, Elder Ln", PhoneticName:"75th Street, Elder Ln", Synonyms:[]string{"75th Street", "Elder Ln", "Elder Ln, 75th Street"}, Transfers:[]Transfer{Transfer{StopID:"J17", Route:"J"}}}, Stop{ID:"J20", MTAName:"Crescent St", DisplayName:"Crescent Street", PhoneticName:"Crescent Street", Synonyms:[]string{"Crescent Street"}, Transfers:[]Transfer{Transfer{StopID:"J20", Route:"J"}}}, Stop{ID:"J21", MTAName:"Norwood Av", DisplayName:"Norwood Avenue", PhoneticName:"Norwood Avenue", Synonyms:[]string{"Norwood Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J21", Route:"J"}}}, Stop{ID:"J23", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J23", Route:"J"}}}, Stop{ID:"J24", MTAName:"Alabama Av", DisplayName:"Alabama Avenue", PhoneticName:"Alabama Avenue", Synonyms:[]string{"Alabama Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J24", Route:"J"}}}, Stop{ID:"J27", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A"}, Transfer{StopID:"A51", Route:"C"}, Transfer{StopID:"J27", Route:"J"}, Transfer{StopID:"L22", Route:"L"}}}, Stop{ID:"J28", MTAName:"Chauncey St", DisplayName:"Chauncey Street", PhoneticName:"Chauncey Street", Synonyms:[]string{"Chauncey Street"}, Transfers:[]Transfer{Transfer{StopID:"J28", Route:"J"}}}, Stop{ID:"J30", MTAName:"Gates Av", DisplayName:"Gates Avenue", PhoneticName:"Gates Avenue", Synonyms:[]string{"Gates Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J30", Route:"J"}}}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"J"}, Transfer{StopID:"M11", Route:"M"}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"J"}, Transfer{StopID:"M16", Route:"M"}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F"}, Transfer{StopID:"M18", Route:"J"}, Transfer{StopID:"M18", Route:"M"}}}, Stop{ID:"M19", MTAName:"Bowery", DisplayName:"Bowery", PhoneticName:"Bowery", Synonyms:[]string{"Bowery"}, Transfers:[]Transfer{Transfer{StopID:"M19", Route:"J"}}}, Stop{ID:"M20", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4"}, Transfer{StopID:"639", Route:"6"}, Transfer{StopID:"639", Route:"6X"}, Transfer{StopID:"M20", Route:"J"}, Transfer{StopID:"R23", Route:"N"}, Transfer{StopID:"Q01", Route:"Q"}, Transfer{StopID:"R23", Route:"R"}, Transfer{StopID:"R23", Route:"W"}}}, Stop{ID:"M21", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4"}, Transfer{StopID:"640", Route:"5"}, Transfer{StopID:"640", Route:"5X"}, Transfer{StopID:"640", Route:"6"}, Transfer{StopID:"640", Route:"6X"}, Transfer{StopID:"M21", Route:"J"}}}, Stop{ID:"M22", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2"}, Transfer{StopID:"229", Route:"3"}, Transfer{StopID:"418", Route:"4"}, Transfer{StopID:"418", Route:"5"}, Transfer{StopID:"418", Route:"5X"}, Transfer{StopID:"A38", Route:"A"}, Transfer{StopID:"A38", Route:"C"}, Transfer{StopID:"M22", Route:"J"}}}, Stop{ID:"M23", MTAName:"Broad St", DisplayName:"Broad Street", PhoneticName:"Broad Street", Synonyms:[]string{"Broad Street"}, Transfers:[]Transfer{Transfer{StopID:"M23", Route:"J"}}}}}}
+var NYCSubwayRoutes = map[string]Route{"1":Route{Name:"1", Northbound:"Bronx", Southbound:"South Ferry", Stops:[]Stop{Stop{ID:"101", MTAName:"Van Cortlandt Park - 242 St", DisplayName:"Van Cortlandt Park - 242nd Street", PhoneticName:"Van Cortlandt Park, 242nd Street", Lat:40.889248, Lon:-73.898583, Synonyms:[]string{"Van Cortlandt Park", "242nd Street", "242nd Street, Van Cortlandt Park", "Van Cortlandt Park, 242nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"103", MTAName:"238 St", DisplayName:"238th Street", PhoneticName:"238th Street", Lat:40.884667, Lon:-73.90087, Synonyms:[]string{"238th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"104", MTAName:"231 St", DisplayName:"231st Street", PhoneticName:"231st Street", Lat:40.878856, Lon:-73.904834, Synonyms:[]string{"231st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"106", MTAName:"Marble Hill - 225 St", DisplayName:"Marble Hill - 225th Street", PhoneticName:"Marble Hill, 225th Street", Lat:40.874561, Lon:-73.909831, Synonyms:[]string{"Marble Hill", "225th Street", "225th Street, Marble Hill", "Marble Hill, 225th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"107", MTAName:"215 St", DisplayName:"215th Street", PhoneticName:"215th Street", Lat:40.869444, Lon:-73.915279, Synonyms:[]string{"215th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"108", MTAName:"207 St", DisplayName:"207th Street", PhoneticName:"207th Street", Lat:40.864621, Lon:-73.918822, Synonyms:[]string{"207th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"109", MTAName:"Dyckman St", DisplayName:"Dyckman Street", PhoneticName:"Dyckman Street", Lat:40.860531, Lon:-73.925536, Synonyms:[]string{"Dyckman Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"110", MTAName:"191 St", DisplayName:"191st Street", PhoneticName:"191st Street", Lat:40.855225, Lon:-73.929412, Synonyms:[]string{"191st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"111", MTAName:"181 St", DisplayName:"181st Street", PhoneticName:"181st Street", Lat:40.849505, Lon:-73.933596, Synonyms:[]string{"181st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"112", MTAName:"168 St - Washington Hts", DisplayName:"168th Street, Washington Heights", PhoneticName:"168th Street, Washington Heights", Lat:40.840556, Lon:-73.940133, Synonyms:[]string{"168th Street", "Washington Heights", "Washington Heights, 168th Street"}, Transfers:[]Transfer{Transfer{StopID:"A09", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A09", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"113", MTAName:"157 St", DisplayName:"157th Street", PhoneticName:"157th Street", Lat:40.834041, Lon:-73.94489, Synonyms:[]string{"157th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"114", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.826551, Lon:-73.95036, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"115", MTAName:"137 St - City College", DisplayName:"137th Street, City College", PhoneticName:"137th Street, City College", Lat:40.822008, Lon:-73.953676, Synonyms:[]string{"137th Street", "City College", "City College, 137th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"116", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.815581, Lon:-73.958372, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"117", MTAName:"116 St - Columbia University", DisplayName:"116th Street, Columbia University", PhoneticName:"116th Street, Columbia University", Lat:40.807722, Lon:-73.96411, Synonyms:[]string{"116th Street", "Columbia University", "Columbia University, 116th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"118", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Lat:40.803967, Lon:-73.966847, Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"119", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.799446, Lon:-73.968379, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.793919, Lon:-73.972323, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"120", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"121", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.788644, Lon:-73.976218, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"121", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"122", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Lat:40.783934, Lon:-73.979917, Synonyms:[]string{"79th Street"}, Transfers:[]Transfer{Transfer{StopID:"122", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.778453, Lon:-73.98197, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"123", Route:"3", Type:2, MinTransferSeconds:0}}}, Stop{ID:"124", MTAName:"66 St - Lincoln Center", DisplayName:"66th Street, Lincoln Center", PhoneticName:"66th Street, Lincoln Center", Lat:40.77344, Lon:-73.982209, Synonyms:[]string{"66th Street", "Lincoln Center", "Lincoln Center, 66th Street"}, Transfers:[]Transfer{Transfer{StopID:"124", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"125", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768247, Lon:-73.981929, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"126", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.761728, Lon:-73.983849, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"126", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.75529, Lon:-73.987495, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.750373, Lon:-73.991057, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"128", Route:"3", Type:2, MinTransferSeconds:300}}}, Stop{ID:"129", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.747215, Lon:-73.993365, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"129", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"130", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.744081, Lon:-73.995657, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"130", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"131", MTAName:"18 St", DisplayName:"18th Street", PhoneticName:"18th Street", Lat:40.74104, Lon:-73.997871, Synonyms:[]string{"18th Street"}, Transfers:[]Transfer{Transfer{StopID:"131", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.737826, Lon:-74.000201, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"132", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D19", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"L02", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"133", MTAName:"Christopher St - Sheridan Sq", DisplayName:"Christopher Street, Sheridan Square", PhoneticName:"Christopher Street, Sheridan Square", Lat:40.733422, Lon:-74.002906, Synonyms:[]string{"Christopher Street", "Sheridan Square", "Sheridan Square, Christopher Street"}, Transfers:[]Transfer{Transfer{StopID:"133", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"134", MTAName:"Houston St", DisplayName:"Houston Street", PhoneticName:"Houston Street", Lat:40.728251, Lon:-74.005367, Synonyms:[]string{"Houston Street"}, Transfers:[]Transfer{Transfer{StopID:"134", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"135", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.722854, Lon:-74.006277, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"135", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"136", MTAName:"Franklin St", DisplayName:"Franklin Street", PhoneticName:"Franklin Street", Lat:40.719318, Lon:-74.006886, Synonyms:[]string{"Franklin Street"}, Transfers:[]Transfer{Transfer{StopID:"136", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.715478, Lon:-74.009266, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"137", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"138", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Lat:40.711835, Lon:-74.012188, Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"139", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Lat:40.707513, Lon:-74.013783, Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"142", MTAName:"South Ferry", DisplayName:"South Ferry", PhoneticName:"South Ferry", Lat:40.702068, Lon:-74.013664, Synonyms:[]string{"South Ferry"}, Transfers:[]Transfer(nil)}}}, "2":Route{Name:"2", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"201", MTAName:"Wakefield - 241 St", DisplayName:"Wakefield - 241st Street", PhoneticName:"Wakefield, 241st Street", Lat:40.903125, Lon:-73.85062, Synonyms:[]string{"Wakefield", "241st Street", "241st Street, Wakefield", "Wakefield, 241st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"204", MTAName:"Nereid Av", DisplayName:"Nereid Avenue", PhoneticName:"Nereid Avenue", Lat:40.898379, Lon:-73.854376, Synonyms:[]string{"Nereid Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"205", MTAName:"233 St", DisplayName:"233rd Street", PhoneticName:"233rd Street", Lat:40.893193, Lon:-73.857473, Synonyms:[]string{"233rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"206", MTAName:"225 St", DisplayName:"225th Street", PhoneticName:"225th Street", Lat:40.888022, Lon:-73.860341, Synonyms:[]string{"225th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"207", MTAName:"219 St", DisplayName:"219th Street", PhoneticName:"219th Street", Lat:40.883895, Lon:-73.862633, Synonyms:[]string{"219th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"208", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Lat:40.87785, Lon:-73.866256, Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"209", MTAName:"Burke Av", DisplayName:"Burke Avenue", PhoneticName:"Burke Avenue", Lat:40.871356, Lon:-73.867164, Synonyms:[]string{"Burke Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"210", MTAName:"Allerton Av", DisplayName:"Allerton Avenue", PhoneticName:"Allerton Avenue", Lat:40.865462, Lon:-73.867352, Synonyms:[]string{"Allerton Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"211", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Lat:40.857192, Lon:-73.867615, Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"212", MTAName:"Bronx Park East", DisplayName:"Bronx Park East", PhoneticName:"Bronx Park East", Lat:40.848828, Lon:-73.868457, Synonyms:[]string{"Bronx Park East"}, Transfers:[]Transfer(nil)}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Lat:40.841894, Lon:-73.873488, Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"213", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"214", MTAName:"West Farms Sq - E Tremont Av", DisplayName:"West Farms Square - East Tremont Avenue", PhoneticName:"West Farms Square, East Tremont Avenue", Lat:40.840295, Lon:-73.880049, Synonyms:[]string{"West Farms Square", "East Tremont Avenue", "East Tremont Avenue, West Farms Square", "West Farms Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"214", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"215", MTAName:"174 St", DisplayName:"174th Street", PhoneticName:"174th Street", Lat:40.837288, Lon:-73.887734, Synonyms:[]string{"174th Street"}, Transfers:[]Transfer{Transfer{StopID:"215", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"216", MTAName:"Freeman St", DisplayName:"Freeman Street", PhoneticName:"Freeman Street", Lat:40.829993, Lon:-73.891865, Synonyms:[]string{"Freeman Street"}, Transfers:[]Transfer{Transfer{StopID:"216", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"217", MTAName:"Simpson St", DisplayName:"Simpson Street", PhoneticName:"Simpson Street", Lat:40.824073, Lon:-73.893064, Synonyms:[]string{"Simpson Street"}, Transfers:[]Transfer{Transfer{StopID:"217", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"218", MTAName:"Intervale Av", DisplayName:"Intervale Avenue", PhoneticName:"Intervale Avenue", Lat:40.822181, Lon:-73.896736, Synonyms:[]string{"Intervale Avenue"}, Transfers:[]Transfer{Transfer{StopID:"218", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"219", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.819585, Lon:-73.90177, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"219", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"220", MTAName:"Jackson Av", DisplayName:"Jackson Avenue", PhoneticName:"Jackson Avenue", Lat:40.81649, Lon:-73.907807, Synonyms:[]string{"Jackson Avenue"}, Transfers:[]Transfer{Transfer{StopID:"220", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Lat:40.816109, Lon:-73.917757, Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"221", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Lat:40.81841, Lon:-73.926718, Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"415", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"224", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Lat:40.814229, Lon:-73.94077, Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"224", Route:"3", Type:0, MinTransferSeconds:0}}}, Stop{ID:"225", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.807754, Lon:-73.945495, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"225", Route:"3", Type:0, MinTransferSeconds:0}}}, Stop{ID:"226", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.802098, Lon:-73.949625, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"226", Route:"3", Type:0, MinTransferSeconds:0}}}, Stop{ID:"227", MTAName:"Central Park North (110 St)", DisplayName:"Central Park North, 110th Street", PhoneticName:"Central Park North, 110th Street", Lat:40.799075, Lon:-73.951822, Synonyms:[]string{"Central Park North", "110th Street", "110th Street, Central Park North"}, Transfers:[]Transfer{Transfer{StopID:"227", Route:"3", Type:2, MinTransferSeconds:0}}}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.793919, Lon:-73.972323, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"120", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"121", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.788644, Lon:-73.976218, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"121", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"122", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Lat:40.783934, Lon:-73.979917, Synonyms:[]string{"79th Street"}, Transfers:[]Transfer{Transfer{StopID:"122", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.778453, Lon:-73.98197, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"123", Route:"3", Type:2, MinTransferSeconds:0}}}, Stop{ID:"124", MTAName:"66 St - Lincoln Center", DisplayName:"66th Street, Lincoln Center", PhoneticName:"66th Street, Lincoln Center", Lat:40.77344, Lon:-73.982209, Synonyms:[]string{"66th Street", "Lincoln Center", "Lincoln Center, 66th Street"}, Transfers:[]Transfer{Transfer{StopID:"124", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"125", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768247, Lon:-73.981929, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"126", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.761728, Lon:-73.983849, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"126", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.75529, Lon:-73.987495, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.750373, Lon:-73.991057, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"128", Route:"3", Type:2, MinTransferSeconds:300}}}, Stop{ID:"129", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.747215, Lon:-73.993365, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"129", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"130", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.744081, Lon:-73.995657, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"130", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"131", MTAName:"18 St", DisplayName:"18th Street", PhoneticName:"18th Street", Lat:40.74104, Lon:-73.997871, Synonyms:[]string{"18th Street"}, Transfers:[]Transfer{Transfer{StopID:"131", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.737826, Lon:-74.000201, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"132", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D19", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"L02", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"133", MTAName:"Christopher St - Sheridan Sq", DisplayName:"Christopher Street, Sheridan Square", PhoneticName:"Christopher Street, Sheridan Square", Lat:40.733422, Lon:-74.002906, Synonyms:[]string{"Christopher Street", "Sheridan Square", "Sheridan Square, Christopher Street"}, Transfers:[]Transfer{Transfer{StopID:"133", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"134", MTAName:"Houston St", DisplayName:"Houston Street", PhoneticName:"Houston Street", Lat:40.728251, Lon:-74.005367, Synonyms:[]string{"Houston Street"}, Transfers:[]Transfer{Transfer{StopID:"134", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"135", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.722854, Lon:-74.006277, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"135", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"136", MTAName:"Franklin St", DisplayName:"Franklin Street", PhoneticName:"Franklin Street", Lat:40.719318, Lon:-74.006886, Synonyms:[]string{"Franklin Street"}, Transfers:[]Transfer{Transfer{StopID:"136", Route:"1", Type:2, MinTransferSeconds:180}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.715478, Lon:-74.009266, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"137", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"228", MTAName:"Park Pl", DisplayName:"Park Place", PhoneticName:"Park Place", Lat:40.713051, Lon:-74.008811, Synonyms:[]string{"Park Place"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R25", Route:"N", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"R", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"W", Type:2, MinTransferSeconds:420}}}, Stop{ID:"229", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.709416, Lon:-74.006571, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"230", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Lat:40.706821, Lon:-74.0091, Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"230", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"231", MTAName:"Clark St", DisplayName:"Clark Street", PhoneticName:"Clark Street", Lat:40.697466, Lon:-73.993086, Synonyms:[]string{"Clark Street"}, Transfers:[]Transfer{Transfer{StopID:"231", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"232", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Lat:40.693219, Lon:-73.989998, Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"233", MTAName:"Hoyt St", DisplayName:"Hoyt Street", PhoneticName:"Hoyt Street", Lat:40.690545, Lon:-73.985065, Synonyms:[]string{"Hoyt Street"}, Transfers:[]Transfer{Transfer{StopID:"233", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Lat:40.688246, Lon:-73.980492, Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.684359, Lon:-73.977666, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Lat:40.680829, Lon:-73.975098, Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"236", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Lat:40.675235, Lon:-73.971046, Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"237", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Lat:40.671987, Lon:-73.964375, Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"238", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.670682, Lon:-73.958131, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"241", MTAName:"President St", DisplayName:"President Street", PhoneticName:"President Street", Lat:40.667883, Lon:-73.950683, Synonyms:[]string{"President Street"}, Transfers:[]Transfer{Transfer{StopID:"241", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"242", MTAName:"Sterling St", DisplayName:"Sterling Street", PhoneticName:"Sterling Street", Lat:40.662742, Lon:-73.95085, Synonyms:[]string{"Sterling Street"}, Transfers:[]Transfer{Transfer{StopID:"242", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"243", MTAName:"Winthrop St", DisplayName:"Winthrop Street", PhoneticName:"Winthrop Street", Lat:40.656652, Lon:-73.9502, Synonyms:[]string{"Winthrop Street"}, Transfers:[]Transfer{Transfer{StopID:"243", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"244", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.650843, Lon:-73.949575, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"244", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"245", MTAName:"Beverly Rd", DisplayName:"Beverly Road", PhoneticName:"Beverly Road", Lat:40.645098, Lon:-73.948959, Synonyms:[]string{"Beverly Road"}, Transfers:[]Transfer{Transfer{StopID:"245", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"246", MTAName:"Newkirk Av", DisplayName:"Newkirk Avenue", PhoneticName:"Newkirk Avenue", Lat:40.639967, Lon:-73.948411, Synonyms:[]string{"Newkirk Avenue"}, Transfers:[]Transfer{Transfer{StopID:"246", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"247", MTAName:"Flatbush Av - Brooklyn College", DisplayName:"Flatbush Avenue, Brooklyn College", PhoneticName:"Flatbush Avenue, Brooklyn College", Lat:40.632836, Lon:-73.947642, Synonyms:[]string{"Flatbush Avenue", "Brooklyn College", "Brooklyn College, Flatbush Avenue"}, Transfers:[]Transfer{Transfer{StopID:"247", Route:"5X", Type:2, MinTransferSeconds:180}}}}}, "3":Route{Name:"3", Northbound:"Harlem", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"301", MTAName:"Harlem - 148 St", DisplayName:"Harlem - 148th Street", PhoneticName:"Harlem, 148th Street", Lat:40.82388, Lon:-73.93647, Synonyms:[]string{"Harlem", "148th Street", "148th Street, Harlem", "Harlem, 148th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"302", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.820421, Lon:-73.936245, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"224", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Lat:40.814229, Lon:-73.94077, Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"224", Route:"2", Type:0, MinTransferSeconds:0}}}, Stop{ID:"225", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.807754, Lon:-73.945495, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"225", Route:"2", Type:0, MinTransferSeconds:0}}}, Stop{ID:"226", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.802098, Lon:-73.949625, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"226", Route:"2", Type:0, MinTransferSeconds:0}}}, Stop{ID:"227", MTAName:"Central Park North (110 St)", DisplayName:"Central Park North, 110th Street", PhoneticName:"Central Park North, 110th Street", Lat:40.799075, Lon:-73.951822, Synonyms:[]string{"Central Park North", "110th Street", "110th Street, Central Park North"}, Transfers:[]Transfer{Transfer{StopID:"227", Route:"2", Type:2, MinTransferSeconds:0}}}, Stop{ID:"120", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.793919, Lon:-73.972323, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"120", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"120", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"123", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.778453, Lon:-73.98197, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"123", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"123", Route:"2", Type:2, MinTransferSeconds:0}}}, Stop{ID:"127", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.75529, Lon:-73.987495, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"128", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.750373, Lon:-73.991057, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"128", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"128", Route:"2", Type:2, MinTransferSeconds:300}}}, Stop{ID:"132", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.737826, Lon:-74.000201, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1", Type:2, MinTransferSeconds:0}, Transfer{StopID:"132", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D19", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"L02", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"137", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.715478, Lon:-74.009266, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"137", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"137", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"228", MTAName:"Park Pl", DisplayName:"Park Place", PhoneticName:"Park Place", Lat:40.713051, Lon:-74.008811, Synonyms:[]string{"Park Place"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R25", Route:"N", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"R", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"W", Type:2, MinTransferSeconds:420}}}, Stop{ID:"229", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.709416, Lon:-74.006571, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"230", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Lat:40.706821, Lon:-74.0091, Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"230", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"231", MTAName:"Clark St", DisplayName:"Clark Street", PhoneticName:"Clark Street", Lat:40.697466, Lon:-73.993086, Synonyms:[]string{"Clark Street"}, Transfers:[]Transfer{Transfer{StopID:"231", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"232", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Lat:40.693219, Lon:-73.989998, Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"233", MTAName:"Hoyt St", DisplayName:"Hoyt Street", PhoneticName:"Hoyt Street", Lat:40.690545, Lon:-73.985065, Synonyms:[]string{"Hoyt Street"}, Transfers:[]Transfer{Transfer{StopID:"233", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Lat:40.688246, Lon:-73.980492, Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.684359, Lon:-73.977666, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Lat:40.680829, Lon:-73.975098, Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"236", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Lat:40.675235, Lon:-73.971046, Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"237", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Lat:40.671987, Lon:-73.964375, Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"238", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.670682, Lon:-73.958131, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Lat:40.669847, Lon:-73.950466, Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"248", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Lat:40.669399, Lon:-73.942161, Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"249", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Lat:40.668897, Lon:-73.932942, Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"250", Route:"5", Type:2, MinTransferSeconds:0}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Lat:40.664717, Lon:-73.92261, Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"251", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Lat:40.661453, Lon:-73.916327, Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"252", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Lat:40.662549, Lon:-73.908946, Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"253", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Lat:40.663515, Lon:-73.902447, Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"254", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L26", Route:"L", Type:2, MinTransferSeconds:300}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Lat:40.664635, Lon:-73.894895, Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"255", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.665449, Lon:-73.889395, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"256", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Lat:40.666235, Lon:-73.884079, Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"257", Route:"5", Type:2, MinTransferSeconds:180}}}}}, "4":Route{Name:"4", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"401", MTAName:"Woodlawn", DisplayName:"Woodlawn", PhoneticName:"Woodlawn", Lat:40.886037, Lon:-73.878751, Synonyms:[]string{"Woodlawn"}, Transfers:[]Transfer(nil)}, Stop{ID:"402", MTAName:"Mosholu Pkwy", DisplayName:"Mosholu Parkway", PhoneticName:"Mosholu Parkway", Lat:40.87975, Lon:-73.884655, Synonyms:[]string{"Mosholu Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"405", MTAName:"Bedford Park Blvd - Lehman College", DisplayName:"Bedford Park Boulevard - Lehman College", PhoneticName:"Bedford Park Boulevard, Lehman College", Lat:40.873412, Lon:-73.890064, Synonyms:[]string{"Bedford Park Boulevard", "Lehman College", "Lehman College, Bedford Park Boulevard", "Bedford Park Boulevard, Lehman College"}, Transfers:[]Transfer(nil)}, Stop{ID:"406", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Lat:40.86776, Lon:-73.897174, Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"407", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Lat:40.862803, Lon:-73.901034, Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"408", MTAName:"183 St", DisplayName:"183rd Street", PhoneticName:"183rd Street", Lat:40.858407, Lon:-73.903879, Synonyms:[]string{"183rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"409", MTAName:"Burnside Av", DisplayName:"Burnside Avenue", PhoneticName:"Burnside Avenue", Lat:40.853453, Lon:-73.907684, Synonyms:[]string{"Burnside Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"410", MTAName:"176 St", DisplayName:"176th Street", PhoneticName:"176th Street", Lat:40.84848, Lon:-73.911794, Synonyms:[]string{"176th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"411", MTAName:"Mt Eden Av", DisplayName:"Mt Eden Avenue", PhoneticName:"Mt Eden Avenue", Lat:40.844434, Lon:-73.914685, Synonyms:[]string{"Mt Eden Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"412", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Lat:40.840075, Lon:-73.917791, Synonyms:[]string{"170th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"413", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Lat:40.835537, Lon:-73.9214, Synonyms:[]string{"167th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"414", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Lat:40.827994, Lon:-73.925831, Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"D11", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D11", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"415", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Lat:40.818375, Lon:-73.927351, Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Lat:40.813224, Lon:-73.929849, Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"416", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.804138, Lon:-73.937594, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.798629, Lon:-73.941617, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"622", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Lat:40.79502, Lon:-73.94425, Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"623", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.7906, Lon:-73.947478, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"624", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.785672, Lon:-73.95107, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"625", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.779492, Lon:-73.955589, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Lat:40.77362, Lon:-73.959874, Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"627", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Lat:40.768141, Lon:-73.96387, Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"628", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.762526, Lon:-73.967967, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Lat:40.757107, Lon:-73.97192, Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751776, Lon:-73.976848, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"723", Route:"7", Type:2, MinTransferSeconds:180}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Lat:40.746081, Lon:-73.982076, Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"632", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.74307, Lon:-73.984264, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"633", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.739864, Lon:-73.986599, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"634", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.734673, Lon:-73.989951, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Lat:40.730054, Lon:-73.99107, Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"636", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Lat:40.725915, Lon:-73.994659, Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.722301, Lon:-73.997141, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"638", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718803, Lon:-74.000193, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Lat:40.713065, Lon:-74.004131, Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710368, Lon:-74.009509, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Lat:40.707557, Lon:-74.011862, Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"419", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Lat:40.704817, Lon:-74.014065, Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"420", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Lat:40.692404, Lon:-73.990151, Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Lat:40.688246, Lon:-73.980492, Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.684359, Lon:-73.977666, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"236", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Lat:40.680829, Lon:-73.975098, Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"236", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"236", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"237", MTAName:"Grand Army Plaza", DisplayName:"Grand Army Plaza", PhoneticName:"Grand Army Plaza", Lat:40.675235, Lon:-73.971046, Synonyms:[]string{"Grand Army Plaza"}, Transfers:[]Transfer{Transfer{StopID:"237", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"237", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"238", MTAName:"Eastern Pkwy - Brooklyn Museum", DisplayName:"Eastern Parkway - Brooklyn Museum", PhoneticName:"Eastern Parkway, Brooklyn Museum", Lat:40.671987, Lon:-73.964375, Synonyms:[]string{"Eastern Parkway", "Brooklyn Museum", "Brooklyn Museum, Eastern Parkway", "Eastern Parkway, Brooklyn Museum"}, Transfers:[]Transfer{Transfer{StopID:"238", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"238", Route:"3", Type:2, MinTransferSeconds:180}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.670682, Lon:-73.958131, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Lat:40.669847, Lon:-73.950466, Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"248", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Lat:40.669399, Lon:-73.942161, Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"249", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Lat:40.668897, Lon:-73.932942, Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"250", Route:"5", Type:2, MinTransferSeconds:0}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Lat:40.664717, Lon:-73.92261, Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"251", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Lat:40.661453, Lon:-73.916327, Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"252", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Lat:40.662549, Lon:-73.908946, Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"253", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Lat:40.663515, Lon:-73.902447, Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"254", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L26", Route:"L", Type:2, MinTransferSeconds:300}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Lat:40.664635, Lon:-73.894895, Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"255", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.665449, Lon:-73.889395, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"256", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Lat:40.666235, Lon:-73.884079, Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"257", Route:"5", Type:2, MinTransferSeconds:180}}}}}, "5":Route{Name:"5", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"501", MTAName:"Eastchester - Dyre Av", DisplayName:"Eastchester - Dyre Avenue", PhoneticName:"Eastchester, Dyre Avenue", Lat:40.8883, Lon:-73.830834, Synonyms:[]string{"Eastchester", "Dyre Avenue", "Dyre Avenue, Eastchester", "Eastchester, Dyre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"501", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"502", MTAName:"Baychester Av", DisplayName:"Baychester Avenue", PhoneticName:"Baychester Avenue", Lat:40.878663, Lon:-73.838591, Synonyms:[]string{"Baychester Avenue"}, Transfers:[]Transfer{Transfer{StopID:"502", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"503", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Lat:40.869526, Lon:-73.846384, Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer{Transfer{StopID:"503", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"504", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Lat:40.858985, Lon:-73.855359, Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer{Transfer{StopID:"504", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"505", MTAName:"Morris Park", DisplayName:"Morris Park", PhoneticName:"Morris Park", Lat:40.854364, Lon:-73.860495, Synonyms:[]string{"Morris Park"}, Transfers:[]Transfer{Transfer{StopID:"505", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Lat:40.841894, Lon:-73.873488, Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"213", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"214", MTAName:"West Farms Sq - E Tremont Av", DisplayName:"West Farms Square - East Tremont Avenue", PhoneticName:"West Farms Square, East Tremont Avenue", Lat:40.840295, Lon:-73.880049, Synonyms:[]string{"West Farms Square", "East Tremont Avenue", "East Tremont Avenue, West Farms Square", "West Farms Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"214", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"215", MTAName:"174 St", DisplayName:"174th Street", PhoneticName:"174th Street", Lat:40.837288, Lon:-73.887734, Synonyms:[]string{"174th Street"}, Transfers:[]Transfer{Transfer{StopID:"215", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"216", MTAName:"Freeman St", DisplayName:"Freeman Street", PhoneticName:"Freeman Street", Lat:40.829993, Lon:-73.891865, Synonyms:[]string{"Freeman Street"}, Transfers:[]Transfer{Transfer{StopID:"216", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"217", MTAName:"Simpson St", DisplayName:"Simpson Street", PhoneticName:"Simpson Street", Lat:40.824073, Lon:-73.893064, Synonyms:[]string{"Simpson Street"}, Transfers:[]Transfer{Transfer{StopID:"217", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"218", MTAName:"Intervale Av", DisplayName:"Intervale Avenue", PhoneticName:"Intervale Avenue", Lat:40.822181, Lon:-73.896736, Synonyms:[]string{"Intervale Avenue"}, Transfers:[]Transfer{Transfer{StopID:"218", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"219", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.819585, Lon:-73.90177, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"219", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"220", MTAName:"Jackson Av", DisplayName:"Jackson Avenue", PhoneticName:"Jackson Avenue", Lat:40.81649, Lon:-73.907807, Synonyms:[]string{"Jackson Avenue"}, Transfers:[]Transfer{Transfer{StopID:"220", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Lat:40.816109, Lon:-73.917757, Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"221", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Lat:40.81841, Lon:-73.926718, Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"415", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Lat:40.813224, Lon:-73.929849, Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"416", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.804138, Lon:-73.937594, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.779492, Lon:-73.955589, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.762526, Lon:-73.967967, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751776, Lon:-73.976848, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"723", Route:"7", Type:2, MinTransferSeconds:180}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.734673, Lon:-73.989951, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Lat:40.713065, Lon:-74.004131, Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710368, Lon:-74.009509, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Lat:40.707557, Lon:-74.011862, Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"419", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Lat:40.704817, Lon:-74.014065, Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"420", Route:"5X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Lat:40.692404, Lon:-73.990151, Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Lat:40.688246, Lon:-73.980492, Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.684359, Lon:-73.977666, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.670682, Lon:-73.958131, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"248", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Lat:40.669847, Lon:-73.950466, Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"248", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"248", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"249", MTAName:"Kingston Av", DisplayName:"Kingston Avenue", PhoneticName:"Kingston Avenue", Lat:40.669399, Lon:-73.942161, Synonyms:[]string{"Kingston Avenue"}, Transfers:[]Transfer{Transfer{StopID:"249", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"249", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"250", MTAName:"Crown Hts - Utica Av", DisplayName:"Crown Heights - Utica Avenue", PhoneticName:"Crown Heights, Utica Avenue", Lat:40.668897, Lon:-73.932942, Synonyms:[]string{"Crown Heights", "Utica Avenue", "Utica Avenue, Crown Heights", "Crown Heights, Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"250", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"250", Route:"4", Type:2, MinTransferSeconds:0}}}, Stop{ID:"251", MTAName:"Sutter Av - Rutland Rd", DisplayName:"Sutter Avenue, Rutland Road", PhoneticName:"Sutter Avenue, Rutland Road", Lat:40.664717, Lon:-73.92261, Synonyms:[]string{"Sutter Avenue", "Rutland Road", "Rutland Road, Sutter Avenue"}, Transfers:[]Transfer{Transfer{StopID:"251", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"251", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"252", MTAName:"Saratoga Av", DisplayName:"Saratoga Avenue", PhoneticName:"Saratoga Avenue", Lat:40.661453, Lon:-73.916327, Synonyms:[]string{"Saratoga Avenue"}, Transfers:[]Transfer{Transfer{StopID:"252", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"252", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"253", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Lat:40.662549, Lon:-73.908946, Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"253", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"253", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"254", MTAName:"Junius St", DisplayName:"Junius Street", PhoneticName:"Junius Street", Lat:40.663515, Lon:-73.902447, Synonyms:[]string{"Junius Street"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"254", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L26", Route:"L", Type:2, MinTransferSeconds:300}}}, Stop{ID:"255", MTAName:"Pennsylvania Av", DisplayName:"Pennsylvania Avenue", PhoneticName:"Pennsylvania Avenue", Lat:40.664635, Lon:-73.894895, Synonyms:[]string{"Pennsylvania Avenue"}, Transfers:[]Transfer{Transfer{StopID:"255", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"255", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"256", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.665449, Lon:-73.889395, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"256", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"256", Route:"4", Type:2, MinTransferSeconds:180}}}, Stop{ID:"257", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Lat:40.666235, Lon:-73.884079, Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer{Transfer{StopID:"257", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"257", Route:"4", Type:2, MinTransferSeconds:180}}}}}, "5X":Route{Name:"5X", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"501", MTAName:"Eastchester - Dyre Av", DisplayName:"Eastchester - Dyre Avenue", PhoneticName:"Eastchester, Dyre Avenue", Lat:40.8883, Lon:-73.830834, Synonyms:[]string{"Eastchester", "Dyre Avenue", "Dyre Avenue, Eastchester", "Eastchester, Dyre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"501", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"502", MTAName:"Baychester Av", DisplayName:"Baychester Avenue", PhoneticName:"Baychester Avenue", Lat:40.878663, Lon:-73.838591, Synonyms:[]string{"Baychester Avenue"}, Transfers:[]Transfer{Transfer{StopID:"502", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"503", MTAName:"Gun Hill Rd", DisplayName:"Gun Hill Road", PhoneticName:"Gun Hill Road", Lat:40.869526, Lon:-73.846384, Synonyms:[]string{"Gun Hill Road"}, Transfers:[]Transfer{Transfer{StopID:"503", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"504", MTAName:"Pelham Pkwy", DisplayName:"Pelham Parkway", PhoneticName:"Pelham Parkway", Lat:40.858985, Lon:-73.855359, Synonyms:[]string{"Pelham Parkway"}, Transfers:[]Transfer{Transfer{StopID:"504", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"505", MTAName:"Morris Park", DisplayName:"Morris Park", PhoneticName:"Morris Park", Lat:40.854364, Lon:-73.860495, Synonyms:[]string{"Morris Park"}, Transfers:[]Transfer{Transfer{StopID:"505", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"213", MTAName:"E 180 St", DisplayName:"East 180th Street", PhoneticName:"East 180th Street", Lat:40.841894, Lon:-73.873488, Synonyms:[]string{"East 180th Street"}, Transfers:[]Transfer{Transfer{StopID:"213", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"213", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"221", MTAName:"3 Av - 149 St", DisplayName:"3rd Avenue, 149th Street", PhoneticName:"3rd Avenue, 149th Street", Lat:40.816109, Lon:-73.917757, Synonyms:[]string{"3rd Avenue", "149th Street", "149th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"221", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"221", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"222", MTAName:"149 St - Grand Concourse", DisplayName:"149th Street, Grand Concourse", PhoneticName:"149th Street, Grand Concourse", Lat:40.81841, Lon:-73.926718, Synonyms:[]string{"149th Street", "Grand Concourse", "Grand Concourse, 149th Street"}, Transfers:[]Transfer{Transfer{StopID:"222", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"415", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"222", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"416", MTAName:"138 St - Grand Concourse", DisplayName:"138th Street, Grand Concourse", PhoneticName:"138th Street, Grand Concourse", Lat:40.813224, Lon:-73.929849, Synonyms:[]string{"138th Street", "Grand Concourse", "Grand Concourse, 138th Street"}, Transfers:[]Transfer{Transfer{StopID:"416", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"416", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.804138, Lon:-73.937594, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.779492, Lon:-73.955589, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.762526, Lon:-73.967967, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751776, Lon:-73.976848, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"723", Route:"7", Type:2, MinTransferSeconds:180}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.734673, Lon:-73.989951, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Lat:40.713065, Lon:-74.004131, Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"418", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710368, Lon:-74.009509, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"419", MTAName:"Wall St", DisplayName:"Wall Street", PhoneticName:"Wall Street", Lat:40.707557, Lon:-74.011862, Synonyms:[]string{"Wall Street"}, Transfers:[]Transfer{Transfer{StopID:"419", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"419", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"420", MTAName:"Bowling Green", DisplayName:"Bowling Green", PhoneticName:"Bowling Green", Lat:40.704817, Lon:-74.014065, Synonyms:[]string{"Bowling Green"}, Transfers:[]Transfer{Transfer{StopID:"420", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"420", Route:"5", Type:2, MinTransferSeconds:180}}}, Stop{ID:"423", MTAName:"Borough Hall", DisplayName:"Borough Hall", PhoneticName:"Borough Hall", Lat:40.692404, Lon:-73.990151, Synonyms:[]string{"Borough Hall"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"234", MTAName:"Nevins St", DisplayName:"Nevins Street", PhoneticName:"Nevins Street", Lat:40.688246, Lon:-73.980492, Synonyms:[]string{"Nevins Street"}, Transfers:[]Transfer{Transfer{StopID:"234", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"234", Route:"5", Type:2, MinTransferSeconds:0}}}, Stop{ID:"235", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.684359, Lon:-73.977666, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"239", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.670682, Lon:-73.958131, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"239", Route:"2", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"3", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"239", Route:"5", Type:2, MinTransferSeconds:0}}}, Stop{ID:"241", MTAName:"President St", DisplayName:"President Street", PhoneticName:"President Street", Lat:40.667883, Lon:-73.950683, Synonyms:[]string{"President Street"}, Transfers:[]Transfer{Transfer{StopID:"241", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"242", MTAName:"Sterling St", DisplayName:"Sterling Street", PhoneticName:"Sterling Street", Lat:40.662742, Lon:-73.95085, Synonyms:[]string{"Sterling Street"}, Transfers:[]Transfer{Transfer{StopID:"242", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"243", MTAName:"Winthrop St", DisplayName:"Winthrop Street", PhoneticName:"Winthrop Street", Lat:40.656652, Lon:-73.9502, Synonyms:[]string{"Winthrop Street"}, Transfers:[]Transfer{Transfer{StopID:"243", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"244", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.650843, Lon:-73.949575, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"244", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"245", MTAName:"Beverly Rd", DisplayName:"Beverly Road", PhoneticName:"Beverly Road", Lat:40.645098, Lon:-73.948959, Synonyms:[]string{"Beverly Road"}, Transfers:[]Transfer{Transfer{StopID:"245", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"246", MTAName:"Newkirk Av", DisplayName:"Newkirk Avenue", PhoneticName:"Newkirk Avenue", Lat:40.639967, Lon:-73.948411, Synonyms:[]string{"Newkirk Avenue"}, Transfers:[]Transfer{Transfer{StopID:"246", Route:"2", Type:2, MinTransferSeconds:180}}}, Stop{ID:"247", MTAName:"Flatbush Av - Brooklyn College", DisplayName:"Flatbush Avenue, Brooklyn College", PhoneticName:"Flatbush Avenue, Brooklyn College", Lat:40.632836, Lon:-73.947642, Synonyms:[]string{"Flatbush Avenue", "Brooklyn College", "Brooklyn College, Flatbush Avenue"}, Transfers:[]Transfer{Transfer{StopID:"247", Route:"2", Type:2, MinTransferSeconds:180}}}}}, "6":Route{Name:"6", Northbound:"Bronx", Southbound:"Brooklyn Brdg", Stops:[]Stop{Stop{ID:"601", MTAName:"Pelham Bay Park", DisplayName:"Pelham Bay Park", PhoneticName:"Pelham Bay Park", Lat:40.852462, Lon:-73.828121, Synonyms:[]string{"Pelham Bay Park"}, Transfers:[]Transfer{Transfer{StopID:"601", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"602", MTAName:"Buhre Av", DisplayName:"Buhre Avenue", PhoneticName:"Buhre Avenue", Lat:40.84681, Lon:-73.832569, Synonyms:[]string{"Buhre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"602", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"603", MTAName:"Middletown Rd", DisplayName:"Middletown Road", PhoneticName:"Middletown Road", Lat:40.843863, Lon:-73.836322, Synonyms:[]string{"Middletown Road"}, Transfers:[]Transfer{Transfer{StopID:"603", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"604", MTAName:"Westchester Sq - E Tremont Av", DisplayName:"Westchester Square - East Tremont Avenue", PhoneticName:"Westchester Square, East Tremont Avenue", Lat:40.839892, Lon:-73.842952, Synonyms:[]string{"Westchester Square", "East Tremont Avenue", "East Tremont Avenue, Westchester Square", "Westchester Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"604", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"606", MTAName:"Zerega Av", DisplayName:"Zerega Avenue", PhoneticName:"Zerega Avenue", Lat:40.836488, Lon:-73.847036, Synonyms:[]string{"Zerega Avenue"}, Transfers:[]Transfer{Transfer{StopID:"606", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"607", MTAName:"Castle Hill Av", DisplayName:"Castle Hill Avenue", PhoneticName:"Castle Hill Avenue", Lat:40.834255, Lon:-73.851222, Synonyms:[]string{"Castle Hill Avenue"}, Transfers:[]Transfer{Transfer{StopID:"607", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"608", MTAName:"Parkchester", DisplayName:"Parkchester", PhoneticName:"Parkchester", Lat:40.833226, Lon:-73.860816, Synonyms:[]string{"Parkchester"}, Transfers:[]Transfer{Transfer{StopID:"608", Route:"6X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"609", MTAName:"St Lawrence Av", DisplayName:"Street Lawrence Avenue", PhoneticName:"Street Lawrence Avenue", Lat:40.831509, Lon:-73.867618, Synonyms:[]string{"Street Lawrence Avenue"}, Transfers:[]Transfer{Transfer{StopID:"609", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"610", MTAName:"Morrison Av- Sound View", DisplayName:"Morrison Av- Sound View", PhoneticName:"Morrison Av- Sound View", Lat:40.829521, Lon:-73.874516, Synonyms:[]string{"Morrison Av- Sound View"}, Transfers:[]Transfer{Transfer{StopID:"610", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"611", MTAName:"Elder Av", DisplayName:"Elder Avenue", PhoneticName:"Elder Avenue", Lat:40.828584, Lon:-73.879159, Synonyms:[]string{"Elder Avenue"}, Transfers:[]Transfer{Transfer{StopID:"611", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"612", MTAName:"Whitlock Av", DisplayName:"Whitlock Avenue", PhoneticName:"Whitlock Avenue", Lat:40.826525, Lon:-73.886283, Synonyms:[]string{"Whitlock Avenue"}, Transfers:[]Transfer{Transfer{StopID:"612", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"613", MTAName:"Hunts Point Av", DisplayName:"Hunts Point Avenue", PhoneticName:"Hunts Point Avenue", Lat:40.820948, Lon:-73.890549, Synonyms:[]string{"Hunts Point Avenue"}, Transfers:[]Transfer{Transfer{StopID:"613", Route:"6X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"614", MTAName:"Longwood Av", DisplayName:"Longwood Avenue", PhoneticName:"Longwood Avenue", Lat:40.816104, Lon:-73.896435, Synonyms:[]string{"Longwood Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"615", MTAName:"E 149 St", DisplayName:"East 149th Street", PhoneticName:"East 149th Street", Lat:40.812118, Lon:-73.904098, Synonyms:[]string{"East 149th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"616", MTAName:"E 143 St - St Mary's St", DisplayName:"East 143rd Street, Street Mary's Street", PhoneticName:"East 143rd Street, Street Mary's Street", Lat:40.808719, Lon:-73.907657, Synonyms:[]string{"East 143rd Street", "Street Mary's Street", "Street Mary's Street, East 143rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"617", MTAName:"Cypress Av", DisplayName:"Cypress Avenue", PhoneticName:"Cypress Avenue", Lat:40.805368, Lon:-73.914042, Synonyms:[]string{"Cypress Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"618", MTAName:"Brook Av", DisplayName:"Brook Avenue", PhoneticName:"Brook Avenue", Lat:40.807566, Lon:-73.91924, Synonyms:[]string{"Brook Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"619", MTAName:"3 Av - 138 St", DisplayName:"3rd Avenue, 138th Street", PhoneticName:"3rd Avenue, 138th Street", Lat:40.810476, Lon:-73.926138, Synonyms:[]string{"3rd Avenue", "138th Street", "138th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"619", Route:"6X", Type:2, MinTransferSeconds:0}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.804138, Lon:-73.937594, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.798629, Lon:-73.941617, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"622", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Lat:40.79502, Lon:-73.94425, Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"623", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.7906, Lon:-73.947478, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"624", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.785672, Lon:-73.95107, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"625", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.779492, Lon:-73.955589, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Lat:40.77362, Lon:-73.959874, Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"627", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Lat:40.768141, Lon:-73.96387, Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"628", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.762526, Lon:-73.967967, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Lat:40.757107, Lon:-73.97192, Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751776, Lon:-73.976848, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"723", Route:"7", Type:2, MinTransferSeconds:180}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Lat:40.746081, Lon:-73.982076, Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"632", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.74307, Lon:-73.984264, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"633", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.739864, Lon:-73.986599, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"634", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.734673, Lon:-73.989951, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Lat:40.730054, Lon:-73.99107, Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"636", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Lat:40.725915, Lon:-73.994659, Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.722301, Lon:-73.997141, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"638", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718803, Lon:-74.000193, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Lat:40.713065, Lon:-74.004131, Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}}}, "6X":Route{Name:"6X", Northbound:"Bronx", Southbound:"Brooklyn Brdg", Stops:[]Stop{Stop{ID:"601", MTAName:"Pelham Bay Park", DisplayName:"Pelham Bay Park", PhoneticName:"Pelham Bay Park", Lat:40.852462, Lon:-73.828121, Synonyms:[]string{"Pelham Bay Park"}, Transfers:[]Transfer{Transfer{StopID:"601", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"602", MTAName:"Buhre Av", DisplayName:"Buhre Avenue", PhoneticName:"Buhre Avenue", Lat:40.84681, Lon:-73.832569, Synonyms:[]string{"Buhre Avenue"}, Transfers:[]Transfer{Transfer{StopID:"602", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"603", MTAName:"Middletown Rd", DisplayName:"Middletown Road", PhoneticName:"Middletown Road", Lat:40.843863, Lon:-73.836322, Synonyms:[]string{"Middletown Road"}, Transfers:[]Transfer{Transfer{StopID:"603", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"604", MTAName:"Westchester Sq - E Tremont Av", DisplayName:"Westchester Square - East Tremont Avenue", PhoneticName:"Westchester Square, East Tremont Avenue", Lat:40.839892, Lon:-73.842952, Synonyms:[]string{"Westchester Square", "East Tremont Avenue", "East Tremont Avenue, Westchester Square", "Westchester Square, East Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"604", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"606", MTAName:"Zerega Av", DisplayName:"Zerega Avenue", PhoneticName:"Zerega Avenue", Lat:40.836488, Lon:-73.847036, Synonyms:[]string{"Zerega Avenue"}, Transfers:[]Transfer{Transfer{StopID:"606", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"607", MTAName:"Castle Hill Av", DisplayName:"Castle Hill Avenue", PhoneticName:"Castle Hill Avenue", Lat:40.834255, Lon:-73.851222, Synonyms:[]string{"Castle Hill Avenue"}, Transfers:[]Transfer{Transfer{StopID:"607", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"608", MTAName:"Parkchester", DisplayName:"Parkchester", PhoneticName:"Parkchester", Lat:40.833226, Lon:-73.860816, Synonyms:[]string{"Parkchester"}, Transfers:[]Transfer{Transfer{StopID:"608", Route:"6", Type:2, MinTransferSeconds:0}}}, Stop{ID:"609", MTAName:"St Lawrence Av", DisplayName:"Street Lawrence Avenue", PhoneticName:"Street Lawrence Avenue", Lat:40.831509, Lon:-73.867618, Synonyms:[]string{"Street Lawrence Avenue"}, Transfers:[]Transfer{Transfer{StopID:"609", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"610", MTAName:"Morrison Av- Sound View", DisplayName:"Morrison Av- Sound View", PhoneticName:"Morrison Av- Sound View", Lat:40.829521, Lon:-73.874516, Synonyms:[]string{"Morrison Av- Sound View"}, Transfers:[]Transfer{Transfer{StopID:"610", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"611", MTAName:"Elder Av", DisplayName:"Elder Avenue", PhoneticName:"Elder Avenue", Lat:40.828584, Lon:-73.879159, Synonyms:[]string{"Elder Avenue"}, Transfers:[]Transfer{Transfer{StopID:"611", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"612", MTAName:"Whitlock Av", DisplayName:"Whitlock Avenue", PhoneticName:"Whitlock Avenue", Lat:40.826525, Lon:-73.886283, Synonyms:[]string{"Whitlock Avenue"}, Transfers:[]Transfer{Transfer{StopID:"612", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"613", MTAName:"Hunts Point Av", DisplayName:"Hunts Point Avenue", PhoneticName:"Hunts Point Avenue", Lat:40.820948, Lon:-73.890549, Synonyms:[]string{"Hunts Point Avenue"}, Transfers:[]Transfer{Transfer{StopID:"613", Route:"6", Type:2, MinTransferSeconds:0}}}, Stop{ID:"619", MTAName:"3 Av - 138 St", DisplayName:"3rd Avenue, 138th Street", PhoneticName:"3rd Avenue, 138th Street", Lat:40.810476, Lon:-73.926138, Synonyms:[]string{"3rd Avenue", "138th Street", "138th Street, 3rd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"619", Route:"6", Type:2, MinTransferSeconds:0}}}, Stop{ID:"621", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.804138, Lon:-73.937594, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"621", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"621", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"622", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.798629, Lon:-73.941617, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"622", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"622", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"623", MTAName:"110 St", DisplayName:"110th Street", PhoneticName:"110th Street", Lat:40.79502, Lon:-73.94425, Synonyms:[]string{"110th Street"}, Transfers:[]Transfer{Transfer{StopID:"623", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"623", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"624", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.7906, Lon:-73.947478, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"624", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"624", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"625", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.785672, Lon:-73.95107, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"625", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"625", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"626", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.779492, Lon:-73.955589, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"626", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"626", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"627", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Lat:40.77362, Lon:-73.959874, Synonyms:[]string{"77th Street"}, Transfers:[]Transfer{Transfer{StopID:"627", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"627", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"628", MTAName:"68 St - Hunter College", DisplayName:"68th Street, Hunter College", PhoneticName:"68th Street, Hunter College", Lat:40.768141, Lon:-73.96387, Synonyms:[]string{"68th Street", "Hunter College", "Hunter College, 68th Street"}, Transfers:[]Transfer{Transfer{StopID:"628", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"628", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"629", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.762526, Lon:-73.967967, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"630", MTAName:"51 St", DisplayName:"51st Street", PhoneticName:"51st Street", Lat:40.757107, Lon:-73.97192, Synonyms:[]string{"51st Street"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"631", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751776, Lon:-73.976848, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"631", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"723", Route:"7", Type:2, MinTransferSeconds:180}}}, Stop{ID:"632", MTAName:"33 St", DisplayName:"33rd Street", PhoneticName:"33rd Street", Lat:40.746081, Lon:-73.982076, Synonyms:[]string{"33rd Street"}, Transfers:[]Transfer{Transfer{StopID:"632", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"632", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"633", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.74307, Lon:-73.984264, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"633", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"633", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"634", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.739864, Lon:-73.986599, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"634", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"634", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"635", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.734673, Lon:-73.989951, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"636", MTAName:"Astor Pl", DisplayName:"Astor Place", PhoneticName:"Astor Place", Lat:40.730054, Lon:-73.99107, Synonyms:[]string{"Astor Place"}, Transfers:[]Transfer{Transfer{StopID:"636", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"636", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"637", MTAName:"Bleecker St", DisplayName:"Bleecker Street", PhoneticName:"Bleecker Street", Lat:40.725915, Lon:-73.994659, Synonyms:[]string{"Bleecker Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"638", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.722301, Lon:-73.997141, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"638", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"638", Route:"6", Type:2, MinTransferSeconds:180}}}, Stop{ID:"639", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718803, Lon:-74.000193, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"640", MTAName:"Brooklyn Bridge - City Hall", DisplayName:"Brooklyn Bridge - City Hall", PhoneticName:"Brooklyn Bridge, City Hall", Lat:40.713065, Lon:-74.004131, Synonyms:[]string{"Brooklyn Bridge", "City Hall", "City Hall, Brooklyn Bridge", "Brooklyn Bridge, City Hall"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:0}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}}}, "7":Route{Name:"7", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"701", MTAName:"Flushing - Main St", DisplayName:"Flushing - Main Street", PhoneticName:"Flushing, Main Street", Lat:40.7596, Lon:-73.83003, Synonyms:[]string{"Flushing", "Main Street", "Main Street, Flushing", "Flushing, Main Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"702", MTAName:"Mets - Willets Point", DisplayName:"Mets - Willets Point", PhoneticName:"Mets, Willets Point", Lat:40.754622, Lon:-73.845625, Synonyms:[]string{"Mets", "Willets Point", "Willets Point, Mets", "Mets, Willets Point"}, Transfers:[]Transfer(nil)}, Stop{ID:"705", MTAName:"111 St", DisplayName:"111st Street", PhoneticName:"111st Street", Lat:40.75173, Lon:-73.855334, Synonyms:[]string{"111st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"706", MTAName:"103 St - Corona Plaza", DisplayName:"103rd Street, Corona Plaza", PhoneticName:"103rd Street, Corona Plaza", Lat:40.749865, Lon:-73.8627, Synonyms:[]string{"103rd Street", "Corona Plaza", "Corona Plaza, 103rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"707", MTAName:"Junction Blvd", DisplayName:"Junction Boulevard", PhoneticName:"Junction Boulevard", Lat:40.749145, Lon:-73.869527, Synonyms:[]string{"Junction Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"708", MTAName:"90 St - Elmhurst Av", DisplayName:"90th Street, Elmhurst Avenue", PhoneticName:"90th Street, Elmhurst Avenue", Lat:40.748408, Lon:-73.876613, Synonyms:[]string{"90th Street", "Elmhurst Avenue", "Elmhurst Avenue, 90th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"709", MTAName:"82 St - Jackson Hts", DisplayName:"82nd Street, Jackson Heights", PhoneticName:"82nd Street, Jackson Heights", Lat:40.747659, Lon:-73.883697, Synonyms:[]string{"82nd Street", "Jackson Heights", "Jackson Heights, 82nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"710", MTAName:"74 St - Broadway", DisplayName:"74th Street, Broadway", PhoneticName:"74th Street, Broadway", Lat:40.746848, Lon:-73.891394, Synonyms:[]string{"74th Street", "Broadway", "Broadway, 74th Street"}, Transfers:[]Transfer{Transfer{StopID:"G14", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"711", MTAName:"69 St", DisplayName:"69th Street", PhoneticName:"69th Street", Lat:40.746325, Lon:-73.896403, Synonyms:[]string{"69th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"712", MTAName:"Woodside - 61 St", DisplayName:"Woodside - 61st Street", PhoneticName:"Woodside, 61st Street", Lat:40.74563, Lon:-73.902984, Synonyms:[]string{"Woodside", "61st Street", "61st Street, Woodside", "Woodside, 61st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"713", MTAName:"52 St", DisplayName:"52nd Street", PhoneticName:"52nd Street", Lat:40.744149, Lon:-73.912549, Synonyms:[]string{"52nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"714", MTAName:"46 St - Bliss St", DisplayName:"46th Street, Bliss Street", PhoneticName:"46th Street, Bliss Street", Lat:40.743132, Lon:-73.918435, Synonyms:[]string{"46th Street", "Bliss Street", "Bliss Street, 46th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"715", MTAName:"40 St - Lowery St", DisplayName:"40th Street, Lowery Street", PhoneticName:"40th Street, Lowery Street", Lat:40.743781, Lon:-73.924016, Synonyms:[]string{"40th Street", "Lowery Street", "Lowery Street, 40th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"716", MTAName:"33 St - Rawson St", DisplayName:"33rd Street, Rawson Street", PhoneticName:"33rd Street, Rawson Street", Lat:40.744587, Lon:-73.930997, Synonyms:[]string{"33rd Street", "Rawson Street", "Rawson Street, 33rd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"718", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Lat:40.750582, Lon:-73.940202, Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"R09", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R09", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"719", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Lat:40.747023, Lon:-73.945264, Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"F09", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"G22", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F09", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"720", MTAName:"Hunters Point Av", DisplayName:"Hunters Point Avenue", PhoneticName:"Hunters Point Avenue", Lat:40.742216, Lon:-73.948916, Synonyms:[]string{"Hunters Point Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"721", MTAName:"Vernon Blvd - Jackson Av", DisplayName:"Vernon Boulevard - Jackson Avenue", PhoneticName:"Vernon Boulevard, Jackson Avenue", Lat:40.742626, Lon:-73.953581, Synonyms:[]string{"Vernon Boulevard", "Jackson Avenue", "Jackson Avenue, Vernon Boulevard", "Vernon Boulevard, Jackson Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"723", MTAName:"Grand Central - 42 St", DisplayName:"Grand Central - 42nd Street", PhoneticName:"Grand Central, 42nd Street", Lat:40.751431, Lon:-73.976041, Synonyms:[]string{"Grand Central", "42nd Street", "42nd Street, Grand Central", "Grand Central, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"631", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"631", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"631", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"631", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"631", Route:"6X", Type:2, MinTransferSeconds:180}}}, Stop{ID:"724", MTAName:"5 Av", DisplayName:"5th Avenue", PhoneticName:"5th Avenue", Lat:40.753821, Lon:-73.981963, Synonyms:[]string{"5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D16", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"725", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.755477, Lon:-73.987691, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"726", MTAName:"34 St - Hudson Yds", DisplayName:"34th Street, Hudson Yds", PhoneticName:"34th Street, Hudson Yds", Lat:40.755882, Lon:-74.00191, Synonyms:[]string{"34th Street", "Hudson Yds", "Hudson Yds, 34th Street"}, Transfers:[]Transfer(nil)}}}, "A":Route{Name:"A", Northbound:"Manhattan", Southbound:"Queens", Stops:[]Stop{Stop{ID:"A02", MTAName:"Inwood - 207 St", DisplayName:"Inwood - 207th Street", PhoneticName:"Inwood, 207th Street", Lat:40.868072, Lon:-73.919899, Synonyms:[]string{"Inwood", "207th Street", "207th Street, Inwood", "Inwood, 207th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A03", MTAName:"Dyckman St", DisplayName:"Dyckman Street", PhoneticName:"Dyckman Street", Lat:40.865491, Lon:-73.927271, Synonyms:[]string{"Dyckman Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A05", MTAName:"190 St", DisplayName:"190th Street", PhoneticName:"190th Street", Lat:40.859022, Lon:-73.93418, Synonyms:[]string{"190th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A06", MTAName:"181 St", DisplayName:"181st Street", PhoneticName:"181st Street", Lat:40.851695, Lon:-73.937969, Synonyms:[]string{"181st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A07", MTAName:"175 St", DisplayName:"175th Street", PhoneticName:"175th Street", Lat:40.847391, Lon:-73.939704, Synonyms:[]string{"175th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A09", MTAName:"168 St", DisplayName:"168th Street", PhoneticName:"168th Street", Lat:40.840719, Lon:-73.939561, Synonyms:[]string{"168th Street"}, Transfers:[]Transfer{Transfer{StopID:"112", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A09", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A10", MTAName:"163 St - Amsterdam Av", DisplayName:"163rd Street, Amsterdam Avenue", PhoneticName:"163rd Street, Amsterdam Avenue", Lat:40.836013, Lon:-73.939892, Synonyms:[]string{"163rd Street", "Amsterdam Avenue", "Amsterdam Avenue, 163rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A10", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A11", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Lat:40.830518, Lon:-73.941514, Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"A11", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A12", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.824783, Lon:-73.944216, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"D13", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A12", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D13", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Lat:40.817894, Lon:-73.947649, Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A14", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.811109, Lon:-73.952343, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.805085, Lon:-73.954882, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A16", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Lat:40.800603, Lon:-73.958161, Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A17", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.796092, Lon:-73.961454, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A18", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.791642, Lon:-73.964696, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A19", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.785868, Lon:-73.968916, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A20", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Lat:40.781433, Lon:-73.972143, Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A21", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.775594, Lon:-73.97641, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A22", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768296, Lon:-73.981736, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"125", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.762456, Lon:-73.985984, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A25", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Lat:40.757308, Lon:-73.989735, Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.752287, Lon:-73.993391, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A28", Route:"E", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.745906, Lon:-73.998041, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A30", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.740893, Lon:-74.00169, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A31", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L01", Route:"L", Type:2, MinTransferSeconds:90}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.726227, Lon:-74.003739, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A33", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.720824, Lon:-74.005229, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A34", Route:"E", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A36", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.714111, Lon:-74.008585, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R25", Route:"N", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"R", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"W", Type:2, MinTransferSeconds:420}}}, Stop{ID:"A38", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710197, Lon:-74.007691, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A40", MTAName:"High St", DisplayName:"High Street", PhoneticName:"High Street", Lat:40.699337, Lon:-73.990531, Synonyms:[]string{"High Street"}, Transfers:[]Transfer{Transfer{StopID:"A40", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.692338, Lon:-73.987342, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A41", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"N", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"R", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"W", Type:2, MinTransferSeconds:90}}}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Lat:40.688484, Lon:-73.985001, Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A42", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A43", MTAName:"Lafayette Av", DisplayName:"Lafayette Avenue", PhoneticName:"Lafayette Avenue", Lat:40.686113, Lon:-73.973946, Synonyms:[]string{"Lafayette Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A43", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A44", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Lat:40.683263, Lon:-73.965838, Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A44", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A45", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.68138, Lon:-73.956848, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A45", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A46", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Lat:40.680438, Lon:-73.950426, Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A46", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A47", MTAName:"Kingston - Throop Avs", DisplayName:"Kingston - Throop Avenues", PhoneticName:"Kingston, Throop Avenues", Lat:40.679921, Lon:-73.940858, Synonyms:[]string{"Kingston", "Throop Avenues", "Throop Avenues, Kingston", "Kingston, Throop Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A47", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A48", MTAName:"Utica Av", DisplayName:"Utica Avenue", PhoneticName:"Utica Avenue", Lat:40.679364, Lon:-73.930729, Synonyms:[]string{"Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A48", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A49", MTAName:"Ralph Av", DisplayName:"Ralph Avenue", PhoneticName:"Ralph Avenue", Lat:40.678822, Lon:-73.920786, Synonyms:[]string{"Ralph Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A49", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A50", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Lat:40.67834, Lon:-73.911946, Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A50", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A51", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Lat:40.678334, Lon:-73.905316, Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"J27", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L22", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A52", MTAName:"Liberty Av", DisplayName:"Liberty Avenue", PhoneticName:"Liberty Avenue", Lat:40.674542, Lon:-73.896548, Synonyms:[]string{"Liberty Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A52", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A53", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.67271, Lon:-73.890358, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A53", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A54", MTAName:"Shepherd Av", DisplayName:"Shepherd Avenue", PhoneticName:"Shepherd Avenue", Lat:40.67413, Lon:-73.88075, Synonyms:[]string{"Shepherd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A54", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A55", MTAName:"Euclid Av", DisplayName:"Euclid Avenue", PhoneticName:"Euclid Avenue", Lat:40.675377, Lon:-73.872106, Synonyms:[]string{"Euclid Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A55", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A57", MTAName:"Grant Av", DisplayName:"Grant Avenue", PhoneticName:"Grant Avenue", Lat:40.677044, Lon:-73.86505, Synonyms:[]string{"Grant Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"A59", MTAName:"80 St", DisplayName:"80th Street", PhoneticName:"80th Street", Lat:40.679371, Lon:-73.858992, Synonyms:[]string{"80th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A60", MTAName:"88 St", DisplayName:"88th Street", PhoneticName:"88th Street", Lat:40.679843, Lon:-73.85147, Synonyms:[]string{"88th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A61", MTAName:"Rockaway Blvd", DisplayName:"Rockaway Boulevard", PhoneticName:"Rockaway Boulevard", Lat:40.680429, Lon:-73.843853, Synonyms:[]string{"Rockaway Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"H01", MTAName:"Aqueduct Racetrack", DisplayName:"Aqueduct Racetrack", PhoneticName:"Aqueduct Racetrack", Lat:40.668234, Lon:-73.834058, Synonyms:[]string{"Aqueduct Racetrack"}, Transfers:[]Transfer(nil)}, Stop{ID:"H02", MTAName:"Aqueduct - N Conduit Av", DisplayName:"Aqueduct - North Conduit Avenue", PhoneticName:"Aqueduct, North Conduit Avenue", Lat:40.668234, Lon:-73.834058, Synonyms:[]string{"Aqueduct", "North Conduit Avenue", "North Conduit Avenue, Aqueduct", "Aqueduct, North Conduit Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"H03", MTAName:"Howard Beach - JFK Airport", DisplayName:"Howard Beach - JFK Airport", PhoneticName:"Howard Beach, JFK Airport", Lat:40.660476, Lon:-73.830301, Synonyms:[]string{"Howard Beach", "JFK Airport", "JFK Airport, Howard Beach", "Howard Beach, JFK Airport"}, Transfers:[]Transfer(nil)}, Stop{ID:"H04", MTAName:"Broad Channel", DisplayName:"Broad Channel", PhoneticName:"Broad Channel", Lat:40.608382, Lon:-73.815925, Synonyms:[]string{"Broad Channel"}, Transfers:[]Transfer(nil)}, Stop{ID:"H06", MTAName:"Beach 67 St", DisplayName:"Beach 67th Street", PhoneticName:"Beach 67th Street", Lat:40.590927, Lon:-73.796924, Synonyms:[]string{"Beach 67th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H07", MTAName:"Beach 60 St", DisplayName:"Beach 60th Street", PhoneticName:"Beach 60th Street", Lat:40.592374, Lon:-73.788522, Synonyms:[]string{"Beach 60th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H08", MTAName:"Beach 44 St", DisplayName:"Beach 44th Street", PhoneticName:"Beach 44th Street", Lat:40.592943, Lon:-73.776013, Synonyms:[]string{"Beach 44th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H09", MTAName:"Beach 36 St", DisplayName:"Beach 36th Street", PhoneticName:"Beach 36th Street", Lat:40.595398, Lon:-73.768175, Synonyms:[]string{"Beach 36th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H10", MTAName:"Beach 25 St", DisplayName:"Beach 25th Street", PhoneticName:"Beach 25th Street", Lat:40.600066, Lon:-73.761353, Synonyms:[]string{"Beach 25th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"H11", MTAName:"Far Rockaway - Mott Av", DisplayName:"Far Rockaway - Mott Avenue", PhoneticName:"Far Rockaway, Mott Avenue", Lat:40.603995, Lon:-73.755405, Synonyms:[]string{"Far Rockaway", "Mott Avenue", "Mott Avenue, Far Rockaway", "Far Rockaway, Mott Avenue"}, Transfers:[]Transfer(nil)}}}, "B":Route{Name:"B", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"D03", MTAName:"Bedford Park Blvd", DisplayName:"Bedford Park Boulevard", PhoneticName:"Bedford Park Boulevard", Lat:40.873244, Lon:-73.887138, Synonyms:[]string{"Bedford Park Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"D03", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D04", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Lat:40.866978, Lon:-73.893509, Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer{Transfer{StopID:"D04", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D05", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Lat:40.861296, Lon:-73.897749, Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer{Transfer{StopID:"D05", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D06", MTAName:"182-183 Sts", DisplayName:"182nd 183rd Streets", PhoneticName:"182nd 183rd Streets", Lat:40.856093, Lon:-73.900741, Synonyms:[]string{"182nd 183rd Streets"}, Transfers:[]Transfer{Transfer{StopID:"D06", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D07", MTAName:"Tremont Av", DisplayName:"Tremont Avenue", PhoneticName:"Tremont Avenue", Lat:40.85041, Lon:-73.905227, Synonyms:[]string{"Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D07", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D08", MTAName:"174-175 Sts", DisplayName:"174th 175th Streets", PhoneticName:"174th 175th Streets", Lat:40.8459, Lon:-73.910136, Synonyms:[]string{"174th 175th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D08", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D09", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Lat:40.839306, Lon:-73.9134, Synonyms:[]string{"170th Street"}, Transfers:[]Transfer{Transfer{StopID:"D09", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D10", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Lat:40.833771, Lon:-73.91844, Synonyms:[]string{"167th Street"}, Transfers:[]Transfer{Transfer{StopID:"D10", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D11", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Lat:40.827905, Lon:-73.925651, Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"414", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D11", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D12", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Lat:40.830135, Lon:-73.938209, Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"D12", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D13", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.824783, Lon:-73.944216, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A12", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D13", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Lat:40.817894, Lon:-73.947649, Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A14", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.811109, Lon:-73.952343, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.805085, Lon:-73.954882, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A16", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Lat:40.800603, Lon:-73.958161, Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A17", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.796092, Lon:-73.961454, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A18", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.791642, Lon:-73.964696, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A19", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.785868, Lon:-73.968916, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A20", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Lat:40.781433, Lon:-73.972143, Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A21", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.775594, Lon:-73.97641, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A22", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768296, Lon:-73.981736, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"125", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.762862, Lon:-73.981637, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D14", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Lat:40.758663, Lon:-73.981329, Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"D", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Lat:40.754222, Lon:-73.984569, Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749719, Lon:-73.987823, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Lat:40.725297, Lon:-73.996204, Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D22", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Lat:40.718267, Lon:-73.993753, Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer{Transfer{StopID:"D22", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D24", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.68446, Lon:-73.97689, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D25", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.67705, Lon:-73.972367, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D25", Route:"Q", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D26", MTAName:"Prospect Park", DisplayName:"Prospect Park", PhoneticName:"Prospect Park", Lat:40.661614, Lon:-73.962246, Synonyms:[]string{"Prospect Park"}, Transfers:[]Transfer{Transfer{StopID:"D26", Route:"Q", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D28", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.650527, Lon:-73.962982, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D28", Route:"Q", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D31", MTAName:"Newkirk Plaza", DisplayName:"Newkirk Plaza", PhoneticName:"Newkirk Plaza", Lat:40.635082, Lon:-73.962793, Synonyms:[]string{"Newkirk Plaza"}, Transfers:[]Transfer{Transfer{StopID:"D31", Route:"Q", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Lat:40.60867, Lon:-73.957734, Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"D35", Route:"Q", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D39", MTAName:"Sheepshead Bay", DisplayName:"Sheepshead Bay", PhoneticName:"Sheepshead Bay", Lat:40.586896, Lon:-73.954155, Synonyms:[]string{"Sheepshead Bay"}, Transfers:[]Transfer{Transfer{StopID:"D39", Route:"Q", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D40", MTAName:"Brighton Beach", DisplayName:"Brighton Beach", PhoneticName:"Brighton Beach", Lat:40.577621, Lon:-73.961376, Synonyms:[]string{"Brighton Beach"}, Transfers:[]Transfer{Transfer{StopID:"D40", Route:"Q", Type:2, MinTransferSeconds:300}}}}}, "C":Route{Name:"C", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"A09", MTAName:"168 St", DisplayName:"168th Street", PhoneticName:"168th Street", Lat:40.840719, Lon:-73.939561, Synonyms:[]string{"168th Street"}, Transfers:[]Transfer{Transfer{StopID:"112", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A09", Route:"A", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A10", MTAName:"163 St - Amsterdam Av", DisplayName:"163rd Street, Amsterdam Avenue", PhoneticName:"163rd Street, Amsterdam Avenue", Lat:40.836013, Lon:-73.939892, Synonyms:[]string{"163rd Street", "Amsterdam Avenue", "Amsterdam Avenue, 163rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A10", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A11", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Lat:40.830518, Lon:-73.941514, Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"A11", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A12", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.824783, Lon:-73.944216, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D13", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D13", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A14", MTAName:"135 St", DisplayName:"135th Street", PhoneticName:"135th Street", Lat:40.817894, Lon:-73.947649, Synonyms:[]string{"135th Street"}, Transfers:[]Transfer{Transfer{StopID:"A14", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A14", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.811109, Lon:-73.952343, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A16", MTAName:"116 St", DisplayName:"116th Street", PhoneticName:"116th Street", Lat:40.805085, Lon:-73.954882, Synonyms:[]string{"116th Street"}, Transfers:[]Transfer{Transfer{StopID:"A16", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A16", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A17", MTAName:"Cathedral Pkwy (110 St)", DisplayName:"Cathedral Parkway, 110th Street", PhoneticName:"Cathedral Parkway, 110th Street", Lat:40.800603, Lon:-73.958161, Synonyms:[]string{"Cathedral Parkway", "110th Street", "110th Street, Cathedral Parkway"}, Transfers:[]Transfer{Transfer{StopID:"A17", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A17", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A18", MTAName:"103 St", DisplayName:"103rd Street", PhoneticName:"103rd Street", Lat:40.796092, Lon:-73.961454, Synonyms:[]string{"103rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A18", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A18", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A19", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.791642, Lon:-73.964696, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer{Transfer{StopID:"A19", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A19", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A20", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.785868, Lon:-73.968916, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"A20", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A20", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A21", MTAName:"81 St - Museum of Natural History", DisplayName:"81st Street, Museum of Natural History", PhoneticName:"81st Street, Museum of Natural History", Lat:40.781433, Lon:-73.972143, Synonyms:[]string{"81st Street", "Museum of Natural History", "Museum of Natural History, 81st Street"}, Transfers:[]Transfer{Transfer{StopID:"A21", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A21", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A22", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.775594, Lon:-73.97641, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer{Transfer{StopID:"A22", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A22", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768296, Lon:-73.981736, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"125", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"D", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.762456, Lon:-73.985984, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A25", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Lat:40.757308, Lon:-73.989735, Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.752287, Lon:-73.993391, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A28", Route:"E", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.745906, Lon:-73.998041, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A30", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.740893, Lon:-74.00169, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A31", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L01", Route:"L", Type:2, MinTransferSeconds:90}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.726227, Lon:-74.003739, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A33", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.720824, Lon:-74.005229, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A34", Route:"E", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A36", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.714111, Lon:-74.008585, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R25", Route:"N", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"R", Type:2, MinTransferSeconds:420}, Transfer{StopID:"R25", Route:"W", Type:2, MinTransferSeconds:420}}}, Stop{ID:"A38", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710197, Lon:-74.007691, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A40", MTAName:"High St", DisplayName:"High Street", PhoneticName:"High Street", Lat:40.699337, Lon:-73.990531, Synonyms:[]string{"High Street"}, Transfers:[]Transfer{Transfer{StopID:"A40", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.692338, Lon:-73.987342, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A41", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"N", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"R", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"W", Type:2, MinTransferSeconds:90}}}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Lat:40.688484, Lon:-73.985001, Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A42", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A43", MTAName:"Lafayette Av", DisplayName:"Lafayette Avenue", PhoneticName:"Lafayette Avenue", Lat:40.686113, Lon:-73.973946, Synonyms:[]string{"Lafayette Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A43", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A44", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Lat:40.683263, Lon:-73.965838, Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A44", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A45", MTAName:"Franklin Av", DisplayName:"Franklin Avenue", PhoneticName:"Franklin Avenue", Lat:40.68138, Lon:-73.956848, Synonyms:[]string{"Franklin Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A45", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A46", MTAName:"Nostrand Av", DisplayName:"Nostrand Avenue", PhoneticName:"Nostrand Avenue", Lat:40.680438, Lon:-73.950426, Synonyms:[]string{"Nostrand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A46", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A47", MTAName:"Kingston - Throop Avs", DisplayName:"Kingston - Throop Avenues", PhoneticName:"Kingston, Throop Avenues", Lat:40.679921, Lon:-73.940858, Synonyms:[]string{"Kingston", "Throop Avenues", "Throop Avenues, Kingston", "Kingston, Throop Avenues"}, Transfers:[]Transfer{Transfer{StopID:"A47", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A48", MTAName:"Utica Av", DisplayName:"Utica Avenue", PhoneticName:"Utica Avenue", Lat:40.679364, Lon:-73.930729, Synonyms:[]string{"Utica Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A48", Route:"A", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A49", MTAName:"Ralph Av", DisplayName:"Ralph Avenue", PhoneticName:"Ralph Avenue", Lat:40.678822, Lon:-73.920786, Synonyms:[]string{"Ralph Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A49", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A50", MTAName:"Rockaway Av", DisplayName:"Rockaway Avenue", PhoneticName:"Rockaway Avenue", Lat:40.67834, Lon:-73.911946, Synonyms:[]string{"Rockaway Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A50", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A51", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Lat:40.678334, Lon:-73.905316, Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"J27", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L22", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A52", MTAName:"Liberty Av", DisplayName:"Liberty Avenue", PhoneticName:"Liberty Avenue", Lat:40.674542, Lon:-73.896548, Synonyms:[]string{"Liberty Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A52", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A53", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.67271, Lon:-73.890358, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A53", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A54", MTAName:"Shepherd Av", DisplayName:"Shepherd Avenue", PhoneticName:"Shepherd Avenue", Lat:40.67413, Lon:-73.88075, Synonyms:[]string{"Shepherd Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A54", Route:"A", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A55", MTAName:"Euclid Av", DisplayName:"Euclid Avenue", PhoneticName:"Euclid Avenue", Lat:40.675377, Lon:-73.872106, Synonyms:[]string{"Euclid Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A55", Route:"A", Type:2, MinTransferSeconds:0}}}}}, "D":Route{Name:"D", Northbound:"Bronx", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"D01", MTAName:"Norwood - 205 St", DisplayName:"Norwood - 205th Street", PhoneticName:"Norwood, 205th Street", Lat:40.874811, Lon:-73.878855, Synonyms:[]string{"Norwood", "205th Street", "205th Street, Norwood", "Norwood, 205th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D03", MTAName:"Bedford Park Blvd", DisplayName:"Bedford Park Boulevard", PhoneticName:"Bedford Park Boulevard", Lat:40.873244, Lon:-73.887138, Synonyms:[]string{"Bedford Park Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"D03", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D04", MTAName:"Kingsbridge Rd", DisplayName:"Kingsbridge Road", PhoneticName:"Kingsbridge Road", Lat:40.866978, Lon:-73.893509, Synonyms:[]string{"Kingsbridge Road"}, Transfers:[]Transfer{Transfer{StopID:"D04", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D05", MTAName:"Fordham Rd", DisplayName:"Fordham Road", PhoneticName:"Fordham Road", Lat:40.861296, Lon:-73.897749, Synonyms:[]string{"Fordham Road"}, Transfers:[]Transfer{Transfer{StopID:"D05", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D06", MTAName:"182-183 Sts", DisplayName:"182nd 183rd Streets", PhoneticName:"182nd 183rd Streets", Lat:40.856093, Lon:-73.900741, Synonyms:[]string{"182nd 183rd Streets"}, Transfers:[]Transfer{Transfer{StopID:"D06", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D07", MTAName:"Tremont Av", DisplayName:"Tremont Avenue", PhoneticName:"Tremont Avenue", Lat:40.85041, Lon:-73.905227, Synonyms:[]string{"Tremont Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D07", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D08", MTAName:"174-175 Sts", DisplayName:"174th 175th Streets", PhoneticName:"174th 175th Streets", Lat:40.8459, Lon:-73.910136, Synonyms:[]string{"174th 175th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D08", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D09", MTAName:"170 St", DisplayName:"170th Street", PhoneticName:"170th Street", Lat:40.839306, Lon:-73.9134, Synonyms:[]string{"170th Street"}, Transfers:[]Transfer{Transfer{StopID:"D09", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D10", MTAName:"167 St", DisplayName:"167th Street", PhoneticName:"167th Street", Lat:40.833771, Lon:-73.91844, Synonyms:[]string{"167th Street"}, Transfers:[]Transfer{Transfer{StopID:"D10", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D11", MTAName:"161 St - Yankee Stadium", DisplayName:"161st Street, Yankee Stadium", PhoneticName:"161st Street, Yankee Stadium", Lat:40.827905, Lon:-73.925651, Synonyms:[]string{"161st Street", "Yankee Stadium", "Yankee Stadium, 161st Street"}, Transfers:[]Transfer{Transfer{StopID:"414", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D11", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D12", MTAName:"155 St", DisplayName:"155th Street", PhoneticName:"155th Street", Lat:40.830135, Lon:-73.938209, Synonyms:[]string{"155th Street"}, Transfers:[]Transfer{Transfer{StopID:"D12", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D13", MTAName:"145 St", DisplayName:"145th Street", PhoneticName:"145th Street", Lat:40.824783, Lon:-73.944216, Synonyms:[]string{"145th Street"}, Transfers:[]Transfer{Transfer{StopID:"A12", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D13", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A12", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A15", MTAName:"125 St", DisplayName:"125th Street", PhoneticName:"125th Street", Lat:40.811109, Lon:-73.952343, Synonyms:[]string{"125th Street"}, Transfers:[]Transfer{Transfer{StopID:"A15", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A15", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"A24", MTAName:"59 St - Columbus Circle", DisplayName:"59th Street, Columbus Circle", PhoneticName:"59th Street, Columbus Circle", Lat:40.768296, Lon:-73.981736, Synonyms:[]string{"59th Street", "Columbus Circle", "Columbus Circle, 59th Street"}, Transfers:[]Transfer{Transfer{StopID:"125", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"125", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A24", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A24", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.762862, Lon:-73.981637, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D14", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Lat:40.758663, Lon:-73.981329, Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Lat:40.754222, Lon:-73.984569, Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749719, Lon:-73.987823, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Lat:40.725297, Lon:-73.996204, Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D22", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Lat:40.718267, Lon:-73.993753, Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer{Transfer{StopID:"D22", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.683666, Lon:-73.97881, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Lat:40.677316, Lon:-73.98311, Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670847, Lon:-73.988302, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F23", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.665414, Lon:-73.992872, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Lat:40.660397, Lon:-73.998091, Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.655144, Lon:-74.003549, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"B12", MTAName:"9 Av", DisplayName:"9th Avenue", PhoneticName:"9th Avenue", Lat:40.646292, Lon:-73.994324, Synonyms:[]string{"9th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B13", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Lat:40.640914, Lon:-73.994304, Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"B14", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.63626, Lon:-73.994791, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B15", MTAName:"55 St", DisplayName:"55th Street", PhoneticName:"55th Street", Lat:40.631435, Lon:-73.995476, Synonyms:[]string{"55th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B16", MTAName:"62 St", DisplayName:"62nd Street", PhoneticName:"62nd Street", Lat:40.626472, Lon:-73.996895, Synonyms:[]string{"62nd Street"}, Transfers:[]Transfer{Transfer{StopID:"N04", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"N04", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"B17", MTAName:"71 St", DisplayName:"71st Street", PhoneticName:"71st Street", Lat:40.619589, Lon:-73.998864, Synonyms:[]string{"71st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B18", MTAName:"79 St", DisplayName:"79th Street", PhoneticName:"79th Street", Lat:40.613501, Lon:-74.00061, Synonyms:[]string{"79th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B19", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Lat:40.607954, Lon:-74.001736, Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B20", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Lat:40.604556, Lon:-73.998168, Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B21", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Lat:40.601875, Lon:-73.993728, Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"B22", MTAName:"25 Av", DisplayName:"25th Avenue", PhoneticName:"25th Avenue", Lat:40.597704, Lon:-73.986829, Synonyms:[]string{"25th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"B23", MTAName:"Bay 50 St", DisplayName:"Bay 50th Street", PhoneticName:"Bay 50th Street", Lat:40.588841, Lon:-73.983765, Synonyms:[]string{"Bay 50th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Lat:40.577422, Lon:-73.981233, Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"Q", Type:2, MinTransferSeconds:180}}}}}, "E":Route{Name:"E", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Lat:40.702147, Lon:-73.801109, Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G05", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Lat:40.700486, Lon:-73.807969, Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G06", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G07", MTAName:"Jamaica - Van Wyck", DisplayName:"Jamaica - Van Wyck", PhoneticName:"Jamaica, Van Wyck", Lat:40.702566, Lon:-73.816859, Synonyms:[]string{"Jamaica", "Van Wyck", "Van Wyck, Jamaica", "Jamaica, Van Wyck"}, Transfers:[]Transfer(nil)}, Stop{ID:"F05", MTAName:"Briarwood", DisplayName:"Briarwood", PhoneticName:"Briarwood", Lat:40.709179, Lon:-73.820574, Synonyms:[]string{"Briarwood"}, Transfers:[]Transfer{Transfer{StopID:"F05", Route:"F", Type:0, MinTransferSeconds:0}}}, Stop{ID:"F06", MTAName:"Kew Gardens - Union Tpke", DisplayName:"Kew Gardens - Union Tpke", PhoneticName:"Kew Gardens, Union Tpke", Lat:40.714441, Lon:-73.831008, Synonyms:[]string{"Kew Gardens", "Union Tpke", "Union Tpke, Kew Gardens", "Kew Gardens, Union Tpke"}, Transfers:[]Transfer{Transfer{StopID:"F06", Route:"F", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F07", MTAName:"75 Av", DisplayName:"75th Avenue", PhoneticName:"75th Avenue", Lat:40.718331, Lon:-73.837324, Synonyms:[]string{"75th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F07", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Lat:40.721691, Lon:-73.844521, Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Lat:40.726523, Lon:-73.852719, Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G09", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Lat:40.729846, Lon:-73.861604, Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G10", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Lat:40.733106, Lon:-73.869229, Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G11", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Lat:40.737015, Lon:-73.877223, Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G12", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Lat:40.742454, Lon:-73.882017, Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G13", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Lat:40.746644, Lon:-73.891338, Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Lat:40.749669, Lon:-73.898453, Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G15", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Lat:40.752885, Lon:-73.906006, Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G16", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Lat:40.756312, Lon:-73.913333, Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G18", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Lat:40.756879, Lon:-73.92074, Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G19", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.752039, Lon:-73.928781, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G20", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Lat:40.748973, Lon:-73.937243, Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G21", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F09", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Lat:40.747846, Lon:-73.946, Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7", Type:2, MinTransferSeconds:300}, Transfer{StopID:"G22", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F09", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F11", MTAName:"Lexington Av/53 St", DisplayName:"Lexington Avenue, 53rd Street", PhoneticName:"Lexington Avenue, 53rd Street", Lat:40.757552, Lon:-73.969055, Synonyms:[]string{"Lexington Avenue", "53rd Street", "53rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F12", MTAName:"5 Av/53 St", DisplayName:"5th Avenue, 53rd Street", PhoneticName:"5th Avenue, 53rd Street", Lat:40.760167, Lon:-73.975224, Synonyms:[]string{"5th Avenue", "53rd Street", "53rd Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F12", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D14", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.762862, Lon:-73.981637, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D14", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D14", Route:"D", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A25", MTAName:"50 St", DisplayName:"50th Street", PhoneticName:"50th Street", Lat:40.762456, Lon:-73.985984, Synonyms:[]string{"50th Street"}, Transfers:[]Transfer{Transfer{StopID:"A25", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A25", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A27", MTAName:"42 St - Port Authority Bus Terminal", DisplayName:"42nd Street, Port Authority Bus Terminal", PhoneticName:"42nd Street, Port Authority Bus Terminal", Lat:40.757308, Lon:-73.989735, Synonyms:[]string{"42nd Street", "Port Authority Bus Terminal", "Port Authority Bus Terminal, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A28", MTAName:"34 St - Penn Station", DisplayName:"34th Street, Penn Station", PhoneticName:"34th Street, Penn Station", Lat:40.752287, Lon:-73.993391, Synonyms:[]string{"34th Street", "Penn Station", "Penn Station, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"A28", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A28", Route:"C", Type:2, MinTransferSeconds:300}}}, Stop{ID:"A30", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.745906, Lon:-73.998041, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"A30", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A30", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A31", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.740893, Lon:-74.00169, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A31", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L01", Route:"L", Type:2, MinTransferSeconds:90}}}, Stop{ID:"A32", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A33", MTAName:"Spring St", DisplayName:"Spring Street", PhoneticName:"Spring Street", Lat:40.726227, Lon:-74.003739, Synonyms:[]string{"Spring Street"}, Transfers:[]Transfer{Transfer{StopID:"A33", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A33", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"A34", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.720824, Lon:-74.005229, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"A34", Route:"A", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A34", Route:"C", Type:2, MinTransferSeconds:0}}}, Stop{ID:"E01", MTAName:"World Trade Center", DisplayName:"World Trade Center", PhoneticName:"World Trade Center", Lat:40.712582, Lon:-74.009781, Synonyms:[]string{"World Trade Center"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R25", Route:"N", Type:2, MinTransferSeconds:240}, Transfer{StopID:"R25", Route:"R", Type:2, MinTransferSeconds:240}, Transfer{StopID:"R25", Route:"W", Type:2, MinTransferSeconds:240}}}}}, "F":Route{Name:"F", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"F01", MTAName:"Jamaica - 179 St", DisplayName:"Jamaica - 179th Street", PhoneticName:"Jamaica, 179th Street", Lat:40.712646, Lon:-73.783817, Synonyms:[]string{"Jamaica", "179th Street", "179th Street, Jamaica", "Jamaica, 179th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"F02", MTAName:"169 St", DisplayName:"169th Street", PhoneticName:"169th Street", Lat:40.71047, Lon:-73.793604, Synonyms:[]string{"169th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"F03", MTAName:"Parsons Blvd", DisplayName:"Parsons Boulevard", PhoneticName:"Parsons Boulevard", Lat:40.707564, Lon:-73.803326, Synonyms:[]string{"Parsons Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"F04", MTAName:"Sutphin Blvd", DisplayName:"Sutphin Boulevard", PhoneticName:"Sutphin Boulevard", Lat:40.70546, Lon:-73.810708, Synonyms:[]string{"Sutphin Boulevard"}, Transfers:[]Transfer(nil)}, Stop{ID:"F05", MTAName:"Briarwood", DisplayName:"Briarwood", PhoneticName:"Briarwood", Lat:40.709179, Lon:-73.820574, Synonyms:[]string{"Briarwood"}, Transfers:[]Transfer{Transfer{StopID:"F05", Route:"E", Type:0, MinTransferSeconds:0}}}, Stop{ID:"F06", MTAName:"Kew Gardens - Union Tpke", DisplayName:"Kew Gardens - Union Tpke", PhoneticName:"Kew Gardens, Union Tpke", Lat:40.714441, Lon:-73.831008, Synonyms:[]string{"Kew Gardens", "Union Tpke", "Union Tpke, Kew Gardens", "Kew Gardens, Union Tpke"}, Transfers:[]Transfer{Transfer{StopID:"F06", Route:"E", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F07", MTAName:"75 Av", DisplayName:"75th Avenue", PhoneticName:"75th Avenue", Lat:40.718331, Lon:-73.837324, Synonyms:[]string{"75th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F07", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Lat:40.721691, Lon:-73.844521, Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Lat:40.746644, Lon:-73.891338, Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"B04", MTAName:"21 St - Queensbridge", DisplayName:"21st Street, Queensbridge", PhoneticName:"21st Street, Queensbridge", Lat:40.754203, Lon:-73.942836, Synonyms:[]string{"21st Street", "Queensbridge", "Queensbridge, 21st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B06", MTAName:"Roosevelt Island", DisplayName:"Roosevelt Island", PhoneticName:"Roosevelt Island", Lat:40.759145, Lon:-73.95326, Synonyms:[]string{"Roosevelt Island"}, Transfers:[]Transfer(nil)}, Stop{ID:"B08", MTAName:"Lexington Av/63 St", DisplayName:"Lexington Avenue, 63rd Street", PhoneticName:"Lexington Avenue, 63rd Street", Lat:40.764629, Lon:-73.966113, Synonyms:[]string{"Lexington Avenue", "63rd Street", "63rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"B10", MTAName:"57 St", DisplayName:"57th Street", PhoneticName:"57th Street", Lat:40.763972, Lon:-73.97745, Synonyms:[]string{"57th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Lat:40.758663, Lon:-73.981329, Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"D", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"M", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Lat:40.754222, Lon:-73.984569, Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749719, Lon:-73.987823, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D18", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.742878, Lon:-73.992821, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"D18", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D19", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.738228, Lon:-73.996209, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"132", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"132", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"L02", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Lat:40.725297, Lon:-73.996204, Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F14", MTAName:"2 Av", DisplayName:"2nd Avenue", PhoneticName:"2nd Avenue", Lat:40.723402, Lon:-73.989938, Synonyms:[]string{"2nd Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F15", MTAName:"Delancey St - Essex St", DisplayName:"Delancey Street, Essex Street", PhoneticName:"Delancey Street, Essex Street", Lat:40.718611, Lon:-73.988114, Synonyms:[]string{"Delancey Street", "Essex Street", "Essex Street, Delancey Street"}, Transfers:[]Transfer{Transfer{StopID:"M18", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F16", MTAName:"East Broadway", DisplayName:"East Broadway", PhoneticName:"East Broadway", Lat:40.713715, Lon:-73.990173, Synonyms:[]string{"East Broadway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F18", MTAName:"York St", DisplayName:"York Street", PhoneticName:"York Street", Lat:40.701397, Lon:-73.986751, Synonyms:[]string{"York Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A41", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.692338, Lon:-73.987342, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A41", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"N", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"R", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"W", Type:2, MinTransferSeconds:90}}}, Stop{ID:"F20", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Lat:40.686145, Lon:-73.990862, Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"F20", Route:"G", Type:0, MinTransferSeconds:0}}}, Stop{ID:"F21", MTAName:"Carroll St", DisplayName:"Carroll Street", PhoneticName:"Carroll Street", Lat:40.680303, Lon:-73.995048, Synonyms:[]string{"Carroll Street"}, Transfers:[]Transfer{Transfer{StopID:"F21", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F22", MTAName:"Smith - 9 Sts", DisplayName:"Smith - 9th Streets", PhoneticName:"Smith, 9th Streets", Lat:40.67358, Lon:-73.995959, Synonyms:[]string{"Smith", "9th Streets", "9th Streets, Smith", "Smith, 9th Streets"}, Transfers:[]Transfer{Transfer{StopID:"F22", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F23", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670272, Lon:-73.989779, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F24", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.666271, Lon:-73.980305, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F24", Route:"G", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F25", MTAName:"15 St - Prospect Park", DisplayName:"15th Street, Prospect Park", PhoneticName:"15th Street, Prospect Park", Lat:40.660365, Lon:-73.979493, Synonyms:[]string{"15th Street", "Prospect Park", "Prospect Park, 15th Street"}, Transfers:[]Transfer{Transfer{StopID:"F25", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F26", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Lat:40.650782, Lon:-73.975776, Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"F26", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F27", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.644041, Lon:-73.979678, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F27", Route:"G", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F29", MTAName:"Ditmas Av", DisplayName:"Ditmas Avenue", PhoneticName:"Ditmas Avenue", Lat:40.636119, Lon:-73.978172, Synonyms:[]string{"Ditmas Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F30", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Lat:40.629755, Lon:-73.976971, Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"F31", MTAName:"Avenue I", DisplayName:"Avenue I", PhoneticName:"Avenue I", Lat:40.625322, Lon:-73.976127, Synonyms:[]string{"Avenue I"}, Transfers:[]Transfer(nil)}, Stop{ID:"F32", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Lat:40.620769, Lon:-73.975264, Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F33", MTAName:"Avenue N", DisplayName:"Avenue N", PhoneticName:"Avenue N", Lat:40.61514, Lon:-73.974197, Synonyms:[]string{"Avenue N"}, Transfers:[]Transfer(nil)}, Stop{ID:"F34", MTAName:"Avenue P", DisplayName:"Avenue P", PhoneticName:"Avenue P", Lat:40.608944, Lon:-73.973022, Synonyms:[]string{"Avenue P"}, Transfers:[]Transfer(nil)}, Stop{ID:"F35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Lat:40.603217, Lon:-73.972361, Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer(nil)}, Stop{ID:"F36", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Lat:40.596063, Lon:-73.973357, Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer(nil)}, Stop{ID:"F38", MTAName:"Avenue X", DisplayName:"Avenue X", PhoneticName:"Avenue X", Lat:40.58962, Lon:-73.97425, Synonyms:[]string{"Avenue X"}, Transfers:[]Transfer(nil)}, Stop{ID:"F39", MTAName:"Neptune Av", DisplayName:"Neptune Avenue", PhoneticName:"Neptune Avenue", Lat:40.581011, Lon:-73.974574, Synonyms:[]string{"Neptune Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"D42", MTAName:"W 8 St - NY Aquarium", DisplayName:"West 8th Street, NY Aquarium", PhoneticName:"West 8th Street, NY Aquarium", Lat:40.576127, Lon:-73.975939, Synonyms:[]string{"West 8th Street", "NY Aquarium", "NY Aquarium, West 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"D42", Route:"Q", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Lat:40.577422, Lon:-73.981233, Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"Q", Type:2, MinTransferSeconds:180}}}}}, "G":Route{Name:"G", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G22", MTAName:"Court Sq - 23 St", DisplayName:"Court Square - 23rd Street", PhoneticName:"Court Square, 23rd Street", Lat:40.746554, Lon:-73.943832, Synonyms:[]string{"Court Square", "23rd Street", "23rd Street, Court Square", "Court Square, 23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F09", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F09", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G24", MTAName:"21 St", DisplayName:"21st Street", PhoneticName:"21st Street", Lat:40.744065, Lon:-73.949724, Synonyms:[]string{"21st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"G26", MTAName:"Greenpoint Av", DisplayName:"Greenpoint Avenue", PhoneticName:"Greenpoint Avenue", Lat:40.731352, Lon:-73.954449, Synonyms:[]string{"Greenpoint Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G28", MTAName:"Nassau Av", DisplayName:"Nassau Avenue", PhoneticName:"Nassau Avenue", Lat:40.724635, Lon:-73.951277, Synonyms:[]string{"Nassau Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G29", MTAName:"Metropolitan Av", DisplayName:"Metropolitan Avenue", PhoneticName:"Metropolitan Avenue", Lat:40.712792, Lon:-73.951418, Synonyms:[]string{"Metropolitan Avenue"}, Transfers:[]Transfer{Transfer{StopID:"L10", Route:"L", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G30", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Lat:40.706092, Lon:-73.950308, Synonyms:[]string{"Broadway"}, Transfers:[]Transfer(nil)}, Stop{ID:"G31", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Lat:40.700377, Lon:-73.950234, Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G32", MTAName:"Myrtle - Willoughby Avs", DisplayName:"Myrtle - Willoughby Avenues", PhoneticName:"Myrtle, Willoughby Avenues", Lat:40.694568, Lon:-73.949046, Synonyms:[]string{"Myrtle", "Willoughby Avenues", "Willoughby Avenues, Myrtle", "Myrtle, Willoughby Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G33", MTAName:"Bedford - Nostrand Avs", DisplayName:"Bedford - Nostrand Avenues", PhoneticName:"Bedford, Nostrand Avenues", Lat:40.689627, Lon:-73.953522, Synonyms:[]string{"Bedford", "Nostrand Avenues", "Nostrand Avenues, Bedford", "Bedford, Nostrand Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G34", MTAName:"Classon Av", DisplayName:"Classon Avenue", PhoneticName:"Classon Avenue", Lat:40.688873, Lon:-73.96007, Synonyms:[]string{"Classon Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"G35", MTAName:"Clinton - Washington Avs", DisplayName:"Clinton - Washington Avenues", PhoneticName:"Clinton, Washington Avenues", Lat:40.688089, Lon:-73.966839, Synonyms:[]string{"Clinton", "Washington Avenues", "Washington Avenues, Clinton", "Clinton, Washington Avenues"}, Transfers:[]Transfer(nil)}, Stop{ID:"G36", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.687119, Lon:-73.975375, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"A42", MTAName:"Hoyt - Schermerhorn Sts", DisplayName:"Hoyt - Schermerhorn Streets", PhoneticName:"Hoyt, Schermerhorn Streets", Lat:40.688484, Lon:-73.985001, Synonyms:[]string{"Hoyt", "Schermerhorn Streets", "Schermerhorn Streets, Hoyt", "Hoyt, Schermerhorn Streets"}, Transfers:[]Transfer{Transfer{StopID:"A42", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A42", Route:"C", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F20", MTAName:"Bergen St", DisplayName:"Bergen Street", PhoneticName:"Bergen Street", Lat:40.686145, Lon:-73.990862, Synonyms:[]string{"Bergen Street"}, Transfers:[]Transfer{Transfer{StopID:"F20", Route:"F", Type:0, MinTransferSeconds:0}}}, Stop{ID:"F21", MTAName:"Carroll St", DisplayName:"Carroll Street", PhoneticName:"Carroll Street", Lat:40.680303, Lon:-73.995048, Synonyms:[]string{"Carroll Street"}, Transfers:[]Transfer{Transfer{StopID:"F21", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F22", MTAName:"Smith - 9 Sts", DisplayName:"Smith - 9th Streets", PhoneticName:"Smith, 9th Streets", Lat:40.67358, Lon:-73.995959, Synonyms:[]string{"Smith", "9th Streets", "9th Streets, Smith", "Smith, 9th Streets"}, Transfers:[]Transfer{Transfer{StopID:"F22", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F23", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670272, Lon:-73.989779, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F24", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.666271, Lon:-73.980305, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F24", Route:"F", Type:2, MinTransferSeconds:0}}}, Stop{ID:"F25", MTAName:"15 St - Prospect Park", DisplayName:"15th Street, Prospect Park", PhoneticName:"15th Street, Prospect Park", Lat:40.660365, Lon:-73.979493, Synonyms:[]string{"15th Street", "Prospect Park", "Prospect Park, 15th Street"}, Transfers:[]Transfer{Transfer{StopID:"F25", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F26", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Lat:40.650782, Lon:-73.975776, Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"F26", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F27", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.644041, Lon:-73.979678, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F27", Route:"F", Type:2, MinTransferSeconds:0}}}}}, "J":Route{Name:"J", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Lat:40.702147, Lon:-73.801109, Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G05", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Lat:40.700486, Lon:-73.807969, Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G06", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J12", MTAName:"121 St", DisplayName:"121st Street", PhoneticName:"121st Street", Lat:40.700492, Lon:-73.828294, Synonyms:[]string{"121st Street"}, Transfers:[]Transfer{Transfer{StopID:"J12", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J13", MTAName:"111 St", DisplayName:"111st Street", PhoneticName:"111st Street", Lat:40.697418, Lon:-73.836345, Synonyms:[]string{"111st Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J14", MTAName:"104 St", DisplayName:"104th Street", PhoneticName:"104th Street", Lat:40.695178, Lon:-73.84433, Synonyms:[]string{"104th Street"}, Transfers:[]Transfer{Transfer{StopID:"J14", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J15", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Lat:40.693879, Lon:-73.851576, Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"J15", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J16", MTAName:"85 St - Forest Pkwy", DisplayName:"85th Street, Forest Parkway", PhoneticName:"85th Street, Forest Parkway", Lat:40.692435, Lon:-73.86001, Synonyms:[]string{"85th Street", "Forest Parkway", "Forest Parkway, 85th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J17", MTAName:"75 St - Elder Ln", DisplayName:"75th Street, Elder Ln", PhoneticName:"75th Street, Elder Ln", Lat:40.691324, Lon:-73.867139, Synonyms:[]string{"75th Street", "Elder Ln", "Elder Ln, 75th Street"}, Transfers:[]Transfer{Transfer{StopID:"J17", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J19", MTAName:"Cypress Hills", DisplayName:"Cypress Hills", PhoneticName:"Cypress Hills", Lat:40.689941, Lon:-73.87255, Synonyms:[]string{"Cypress Hills"}, Transfers:[]Transfer(nil)}, Stop{ID:"J20", MTAName:"Crescent St", DisplayName:"Crescent Street", PhoneticName:"Crescent Street", Lat:40.683194, Lon:-73.873785, Synonyms:[]string{"Crescent Street"}, Transfers:[]Transfer{Transfer{StopID:"J20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J21", MTAName:"Norwood Av", DisplayName:"Norwood Avenue", PhoneticName:"Norwood Avenue", Lat:40.68141, Lon:-73.880039, Synonyms:[]string{"Norwood Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J21", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J22", MTAName:"Cleveland St", DisplayName:"Cleveland Street", PhoneticName:"Cleveland Street", Lat:40.679947, Lon:-73.884639, Synonyms:[]string{"Cleveland Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J23", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.678024, Lon:-73.891688, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J23", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J24", MTAName:"Alabama Av", DisplayName:"Alabama Avenue", PhoneticName:"Alabama Avenue", Lat:40.676992, Lon:-73.898654, Synonyms:[]string{"Alabama Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J24", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J27", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Lat:40.679498, Lon:-73.904512, Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A51", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L22", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"Z", Type:2, MinTransferSeconds:0}}}, Stop{ID:"J28", MTAName:"Chauncey St", DisplayName:"Chauncey Street", PhoneticName:"Chauncey Street", Lat:40.682893, Lon:-73.910456, Synonyms:[]string{"Chauncey Street"}, Transfers:[]Transfer{Transfer{StopID:"J28", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J29", MTAName:"Halsey St", DisplayName:"Halsey Street", PhoneticName:"Halsey Street", Lat:40.68637, Lon:-73.916559, Synonyms:[]string{"Halsey Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"J30", MTAName:"Gates Av", DisplayName:"Gates Avenue", PhoneticName:"Gates Avenue", Lat:40.68963, Lon:-73.92227, Synonyms:[]string{"Gates Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J30", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J31", MTAName:"Kosciuszko St", DisplayName:"Kosciuszko Street", PhoneticName:"Kosciuszko Street", Lat:40.693342, Lon:-73.928814, Synonyms:[]string{"Kosciuszko Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Lat:40.697207, Lon:-73.935657, Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M11", Route:"Z", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M12", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Lat:40.70026, Lon:-73.941126, Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M12", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M13", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Lat:40.703869, Lon:-73.947408, Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"M13", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M14", MTAName:"Hewes St", DisplayName:"Hewes Street", PhoneticName:"Hewes Street", Lat:40.70687, Lon:-73.953431, Synonyms:[]string{"Hewes Street"}, Transfers:[]Transfer{Transfer{StopID:"M14", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Lat:40.708359, Lon:-73.957757, Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"M", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M16", Route:"Z", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Lat:40.718315, Lon:-73.987437, Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M19", MTAName:"Bowery", DisplayName:"Bowery", PhoneticName:"Bowery", Lat:40.72028, Lon:-73.993915, Synonyms:[]string{"Bowery"}, Transfers:[]Transfer{Transfer{StopID:"M19", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M20", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718092, Lon:-73.999892, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:300}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M21", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.713243, Lon:-74.003401, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M22", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710374, Lon:-74.007582, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M23", MTAName:"Broad St", DisplayName:"Broad Street", PhoneticName:"Broad Street", Lat:40.706476, Lon:-74.011056, Synonyms:[]string{"Broad Street"}, Transfers:[]Transfer{Transfer{StopID:"M23", Route:"Z", Type:2, MinTransferSeconds:180}}}}}, "L":Route{Name:"L", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"L01", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Lat:40.739777, Lon:-74.002578, Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"A31", Route:"A", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A31", Route:"C", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A31", Route:"E", Type:2, MinTransferSeconds:90}}}, Stop{ID:"L02", MTAName:"6 Av", DisplayName:"6th Avenue", PhoneticName:"6th Avenue", Lat:40.737335, Lon:-73.996786, Synonyms:[]string{"6th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"132", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"132", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D19", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"L03", MTAName:"Union Sq - 14 St", DisplayName:"Union Square - 14th Street", PhoneticName:"Union Square, 14th Street", Lat:40.734789, Lon:-73.99073, Synonyms:[]string{"Union Square", "14th Street", "14th Street, Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"L05", MTAName:"3 Av", DisplayName:"3rd Avenue", PhoneticName:"3rd Avenue", Lat:40.732849, Lon:-73.986122, Synonyms:[]string{"3rd Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L06", MTAName:"1 Av", DisplayName:"1st Avenue", PhoneticName:"1st Avenue", Lat:40.730953, Lon:-73.981628, Synonyms:[]string{"1st Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L08", MTAName:"Bedford Av", DisplayName:"Bedford Avenue", PhoneticName:"Bedford Avenue", Lat:40.717304, Lon:-73.956872, Synonyms:[]string{"Bedford Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L10", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Lat:40.714063, Lon:-73.950275, Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"G29", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"L11", MTAName:"Graham Av", DisplayName:"Graham Avenue", PhoneticName:"Graham Avenue", Lat:40.714565, Lon:-73.944053, Synonyms:[]string{"Graham Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L12", MTAName:"Grand St", DisplayName:"Grand Street", PhoneticName:"Grand Street", Lat:40.711926, Lon:-73.94067, Synonyms:[]string{"Grand Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L13", MTAName:"Montrose Av", DisplayName:"Montrose Avenue", PhoneticName:"Montrose Avenue", Lat:40.707739, Lon:-73.93985, Synonyms:[]string{"Montrose Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L14", MTAName:"Morgan Av", DisplayName:"Morgan Avenue", PhoneticName:"Morgan Avenue", Lat:40.706152, Lon:-73.933147, Synonyms:[]string{"Morgan Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L15", MTAName:"Jefferson St", DisplayName:"Jefferson Street", PhoneticName:"Jefferson Street", Lat:40.706607, Lon:-73.922913, Synonyms:[]string{"Jefferson Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L16", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.703811, Lon:-73.918425, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L17", MTAName:"Myrtle - Wyckoff Avs", DisplayName:"Myrtle - Wyckoff Avenues", PhoneticName:"Myrtle, Wyckoff Avenues", Lat:40.699814, Lon:-73.911586, Synonyms:[]string{"Myrtle", "Wyckoff Avenues", "Wyckoff Avenues, Myrtle", "Myrtle, Wyckoff Avenues"}, Transfers:[]Transfer{Transfer{StopID:"M08", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"L19", MTAName:"Halsey St", DisplayName:"Halsey Street", PhoneticName:"Halsey Street", Lat:40.695602, Lon:-73.904084, Synonyms:[]string{"Halsey Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L20", MTAName:"Wilson Av", DisplayName:"Wilson Avenue", PhoneticName:"Wilson Avenue", Lat:40.688764, Lon:-73.904046, Synonyms:[]string{"Wilson Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L21", MTAName:"Bushwick Av - Aberdeen St", DisplayName:"Bushwick Avenue, Aberdeen Street", PhoneticName:"Bushwick Avenue, Aberdeen Street", Lat:40.682829, Lon:-73.905249, Synonyms:[]string{"Bushwick Avenue", "Aberdeen Street", "Aberdeen Street, Bushwick Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L22", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Lat:40.678856, Lon:-73.90324, Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A51", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"L24", MTAName:"Atlantic Av", DisplayName:"Atlantic Avenue", PhoneticName:"Atlantic Avenue", Lat:40.675345, Lon:-73.903097, Synonyms:[]string{"Atlantic Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L25", MTAName:"Sutter Av", DisplayName:"Sutter Avenue", PhoneticName:"Sutter Avenue", Lat:40.669367, Lon:-73.901975, Synonyms:[]string{"Sutter Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L26", MTAName:"Livonia Av", DisplayName:"Livonia Avenue", PhoneticName:"Livonia Avenue", Lat:40.664038, Lon:-73.900571, Synonyms:[]string{"Livonia Avenue"}, Transfers:[]Transfer{Transfer{StopID:"254", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"254", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"254", Route:"5", Type:2, MinTransferSeconds:300}}}, Stop{ID:"L27", MTAName:"New Lots Av", DisplayName:"New Lots Avenue", PhoneticName:"New Lots Avenue", Lat:40.658733, Lon:-73.899232, Synonyms:[]string{"New Lots Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"L28", MTAName:"E 105 St", DisplayName:"East 105th Street", PhoneticName:"East 105th Street", Lat:40.650573, Lon:-73.899485, Synonyms:[]string{"East 105th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"L29", MTAName:"Canarsie - Rockaway Pkwy", DisplayName:"Canarsie - Rockaway Parkway", PhoneticName:"Canarsie, Rockaway Parkway", Lat:40.646654, Lon:-73.90185, Synonyms:[]string{"Canarsie", "Rockaway Parkway", "Rockaway Parkway, Canarsie", "Canarsie, Rockaway Parkway"}, Transfers:[]Transfer(nil)}}}, "M":Route{Name:"M", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Lat:40.721691, Lon:-73.844521, Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Lat:40.726523, Lon:-73.852719, Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G09", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Lat:40.729846, Lon:-73.861604, Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G10", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Lat:40.733106, Lon:-73.869229, Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G11", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Lat:40.737015, Lon:-73.877223, Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G12", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Lat:40.742454, Lon:-73.882017, Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G13", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Lat:40.746644, Lon:-73.891338, Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Lat:40.749669, Lon:-73.898453, Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G15", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Lat:40.752885, Lon:-73.906006, Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G16", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Lat:40.756312, Lon:-73.913333, Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G18", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Lat:40.756879, Lon:-73.92074, Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G19", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.752039, Lon:-73.928781, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G20", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Lat:40.748973, Lon:-73.937243, Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G21", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F09", MTAName:"Court Sq", DisplayName:"Court Square", PhoneticName:"Court Square", Lat:40.747846, Lon:-73.946, Synonyms:[]string{"Court Square"}, Transfers:[]Transfer{Transfer{StopID:"719", Route:"7", Type:2, MinTransferSeconds:300}, Transfer{StopID:"F09", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G22", Route:"G", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F11", MTAName:"Lexington Av/53 St", DisplayName:"Lexington Avenue, 53rd Street", PhoneticName:"Lexington Avenue, 53rd Street", Lat:40.757552, Lon:-73.969055, Synonyms:[]string{"Lexington Avenue", "53rd Street", "53rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"630", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"630", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F11", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"F12", MTAName:"5 Av/53 St", DisplayName:"5th Avenue, 53rd Street", PhoneticName:"5th Avenue, 53rd Street", Lat:40.760167, Lon:-73.975224, Synonyms:[]string{"5th Avenue", "53rd Street", "53rd Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"F12", Route:"E", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D15", MTAName:"47-50 Sts - Rockefeller Ctr", DisplayName:"47th 50th Streets, Rockefeller Center", PhoneticName:"47th 50th Streets, Rockefeller Center", Lat:40.758663, Lon:-73.981329, Synonyms:[]string{"47th 50th Streets", "Rockefeller Center", "Rockefeller Center, 47th 50th Streets"}, Transfers:[]Transfer{Transfer{StopID:"D15", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"D", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D15", Route:"F", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D16", MTAName:"42 St - Bryant Pk", DisplayName:"42nd Street, Bryant Pk", PhoneticName:"42nd Street, Bryant Pk", Lat:40.754222, Lon:-73.984569, Synonyms:[]string{"42nd Street", "Bryant Pk", "Bryant Pk, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"724", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D16", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D16", Route:"F", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749719, Lon:-73.987823, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D18", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.742878, Lon:-73.992821, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"D18", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D19", MTAName:"14 St", DisplayName:"14th Street", PhoneticName:"14th Street", Lat:40.738228, Lon:-73.996209, Synonyms:[]string{"14th Street"}, Transfers:[]Transfer{Transfer{StopID:"132", Route:"1", Type:2, MinTransferSeconds:300}, Transfer{StopID:"132", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"132", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"D19", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L02", Route:"L", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D20", MTAName:"W 4 St - Wash Sq", DisplayName:"West 4th Street, Wash Square", PhoneticName:"West 4th Street, Wash Square", Lat:40.732338, Lon:-74.000495, Synonyms:[]string{"West 4th Street", "Wash Square", "Wash Square, West 4th Street"}, Transfers:[]Transfer{Transfer{StopID:"A32", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"A32", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D20", Route:"F", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D21", MTAName:"Broadway-Lafayette St", DisplayName:"Broadway-Lafayette Street", PhoneticName:"Broadway-Lafayette Street", Lat:40.725297, Lon:-73.996204, Synonyms:[]string{"Broadway-Lafayette Street"}, Transfers:[]Transfer{Transfer{StopID:"637", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"637", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D21", Route:"B", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D21", Route:"F", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Lat:40.718315, Lon:-73.987437, Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Lat:40.708359, Lon:-73.957757, Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"J", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M16", Route:"Z", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M14", MTAName:"Hewes St", DisplayName:"Hewes Street", PhoneticName:"Hewes Street", Lat:40.70687, Lon:-73.953431, Synonyms:[]string{"Hewes Street"}, Transfers:[]Transfer{Transfer{StopID:"M14", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M13", MTAName:"Lorimer St", DisplayName:"Lorimer Street", PhoneticName:"Lorimer Street", Lat:40.703869, Lon:-73.947408, Synonyms:[]string{"Lorimer Street"}, Transfers:[]Transfer{Transfer{StopID:"M13", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M12", MTAName:"Flushing Av", DisplayName:"Flushing Avenue", PhoneticName:"Flushing Avenue", Lat:40.70026, Lon:-73.941126, Synonyms:[]string{"Flushing Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M12", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Lat:40.697207, Lon:-73.935657, Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"J", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M11", Route:"Z", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M10", MTAName:"Central Av", DisplayName:"Central Avenue", PhoneticName:"Central Avenue", Lat:40.697857, Lon:-73.927397, Synonyms:[]string{"Central Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M09", MTAName:"Knickerbocker Av", DisplayName:"Knickerbocker Avenue", PhoneticName:"Knickerbocker Avenue", Lat:40.698664, Lon:-73.919711, Synonyms:[]string{"Knickerbocker Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M08", MTAName:"Myrtle - Wyckoff Avs", DisplayName:"Myrtle - Wyckoff Avenues", PhoneticName:"Myrtle, Wyckoff Avenues", Lat:40.69943, Lon:-73.912385, Synonyms:[]string{"Myrtle", "Wyckoff Avenues", "Wyckoff Avenues, Myrtle", "Myrtle, Wyckoff Avenues"}, Transfers:[]Transfer{Transfer{StopID:"L17", Route:"L", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M06", MTAName:"Seneca Av", DisplayName:"Seneca Avenue", PhoneticName:"Seneca Avenue", Lat:40.702762, Lon:-73.90774, Synonyms:[]string{"Seneca Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M05", MTAName:"Forest Av", DisplayName:"Forest Avenue", PhoneticName:"Forest Avenue", Lat:40.704423, Lon:-73.903077, Synonyms:[]string{"Forest Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"M04", MTAName:"Fresh Pond Rd", DisplayName:"Fresh Pond Road", PhoneticName:"Fresh Pond Road", Lat:40.706186, Lon:-73.895877, Synonyms:[]string{"Fresh Pond Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"M01", MTAName:"Middle Village - Metropolitan Av", DisplayName:"Middle Village - Metropolitan Avenue", PhoneticName:"Middle Village, Metropolitan Avenue", Lat:40.711396, Lon:-73.889601, Synonyms:[]string{"Middle Village", "Metropolitan Avenue", "Metropolitan Avenue, Middle Village", "Middle Village, Metropolitan Avenue"}, Transfers:[]Transfer(nil)}}}, "N":Route{Name:"N", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"R01", MTAName:"Astoria - Ditmars Blvd", DisplayName:"Astoria - Ditmars Boulevard", PhoneticName:"Astoria, Ditmars Boulevard", Lat:40.775036, Lon:-73.912034, Synonyms:[]string{"Astoria", "Ditmars Boulevard", "Ditmars Boulevard, Astoria", "Astoria, Ditmars Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R01", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R03", MTAName:"Astoria Blvd", DisplayName:"Astoria Boulevard", PhoneticName:"Astoria Boulevard", Lat:40.770258, Lon:-73.917843, Synonyms:[]string{"Astoria Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R03", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R04", MTAName:"30 Av", DisplayName:"30th Avenue", PhoneticName:"30th Avenue", Lat:40.766779, Lon:-73.921479, Synonyms:[]string{"30th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R04", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R05", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Lat:40.76182, Lon:-73.925508, Synonyms:[]string{"Broadway"}, Transfers:[]Transfer{Transfer{StopID:"R05", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R06", MTAName:"36 Av", DisplayName:"36th Avenue", PhoneticName:"36th Avenue", Lat:40.756804, Lon:-73.929575, Synonyms:[]string{"36th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R06", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R08", MTAName:"39 Av - Dutch Kills", DisplayName:"39th Avenue, Dutch Kills", PhoneticName:"39th Avenue, Dutch Kills", Lat:40.752882, Lon:-73.932755, Synonyms:[]string{"39th Avenue", "Dutch Kills", "Dutch Kills, 39th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R08", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R09", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Lat:40.750582, Lon:-73.940202, Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"718", Route:"7", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R09", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Lat:40.76266, Lon:-73.967258, Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Lat:40.764811, Lon:-73.973347, Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R13", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Lat:40.764664, Lon:-73.980658, Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Lat:40.759901, Lon:-73.984139, Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.754672, Lon:-73.986754, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749567, Lon:-73.98795, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.745494, Lon:-73.988691, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.741303, Lon:-73.989344, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.735736, Lon:-73.990568, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Lat:40.730328, Lon:-73.992629, Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Lat:40.724329, Lon:-73.997702, Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.719527, Lon:-74.001775, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Lat:40.713282, Lon:-74.006978, Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R24", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Lat:40.710668, Lon:-74.011029, Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:420}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:420}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:240}, Transfer{StopID:"R25", Route:"R", Type:0, MinTransferSeconds:0}, Transfer{StopID:"R25", Route:"W", Type:0, MinTransferSeconds:0}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Lat:40.70722, Lon:-74.013342, Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R26", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Lat:40.703087, Lon:-74.012994, Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R27", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Lat:40.6941, Lon:-73.991777, Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.69218, Lon:-73.985942, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"C", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"F", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.683666, Lon:-73.97881, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Lat:40.677316, Lon:-73.98311, Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670847, Lon:-73.988302, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.665414, Lon:-73.992872, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Lat:40.660397, Lon:-73.998091, Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.655144, Lon:-74.003549, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Lat:40.648939, Lon:-74.010006, Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R39", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Lat:40.645069, Lon:-74.014034, Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R40", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.641362, Lon:-74.017881, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R41", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"N02", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Lat:40.635064, Lon:-74.011719, Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N02", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N03", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Lat:40.631386, Lon:-74.005351, Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N03", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N04", MTAName:"New Utrecht Av", DisplayName:"New Utrecht Avenue", PhoneticName:"New Utrecht Avenue", Lat:40.624842, Lon:-73.996353, Synonyms:[]string{"New Utrecht Avenue"}, Transfers:[]Transfer{Transfer{StopID:"B16", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"N04", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N05", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Lat:40.620671, Lon:-73.990414, Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N05", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N06", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Lat:40.61741, Lon:-73.985026, Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N06", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N07", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Lat:40.611815, Lon:-73.981848, Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N07", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N08", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Lat:40.603923, Lon:-73.980353, Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"N08", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N09", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Lat:40.597473, Lon:-73.979137, Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer{Transfer{StopID:"N09", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N10", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.592721, Lon:-73.97823, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"N10", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Lat:40.577422, Lon:-73.981233, Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"Q", Type:2, MinTransferSeconds:180}}}}}, "Q":Route{Name:"Q", Northbound:"Manhattan", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"Q05", MTAName:"96 St", DisplayName:"96th Street", PhoneticName:"96th Street", Lat:40.784318, Lon:-73.947152, Synonyms:[]string{"96th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"Q04", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.777891, Lon:-73.951787, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"Q03", MTAName:"72 St", DisplayName:"72nd Street", PhoneticName:"72nd Street", Lat:40.768799, Lon:-73.958424, Synonyms:[]string{"72nd Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"B08", MTAName:"Lexington Av/63 St", DisplayName:"Lexington Avenue, 63rd Street", PhoneticName:"Lexington Avenue, 63rd Street", Lat:40.764629, Lon:-73.966113, Synonyms:[]string{"Lexington Avenue", "63rd Street", "63rd Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:300}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Lat:40.764664, Lon:-73.980658, Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Lat:40.759901, Lon:-73.984139, Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.754672, Lon:-73.986754, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749567, Lon:-73.98795, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.745494, Lon:-73.988691, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.741303, Lon:-73.989344, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.735736, Lon:-73.990568, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Lat:40.730328, Lon:-73.992629, Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Lat:40.724329, Lon:-73.997702, Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"Q01", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718383, Lon:-74.00046, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D24", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.68446, Lon:-73.97689, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D25", MTAName:"7 Av", DisplayName:"7th Avenue", PhoneticName:"7th Avenue", Lat:40.67705, Lon:-73.972367, Synonyms:[]string{"7th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D25", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D26", MTAName:"Prospect Park", DisplayName:"Prospect Park", PhoneticName:"Prospect Park", Lat:40.661614, Lon:-73.962246, Synonyms:[]string{"Prospect Park"}, Transfers:[]Transfer{Transfer{StopID:"D26", Route:"B", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D27", MTAName:"Parkside Av", DisplayName:"Parkside Avenue", PhoneticName:"Parkside Avenue", Lat:40.655292, Lon:-73.961495, Synonyms:[]string{"Parkside Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"D28", MTAName:"Church Av", DisplayName:"Church Avenue", PhoneticName:"Church Avenue", Lat:40.650527, Lon:-73.962982, Synonyms:[]string{"Church Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D28", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D29", MTAName:"Beverley Rd", DisplayName:"Beverley Road", PhoneticName:"Beverley Road", Lat:40.644031, Lon:-73.964492, Synonyms:[]string{"Beverley Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D30", MTAName:"Cortelyou Rd", DisplayName:"Cortelyou Road", PhoneticName:"Cortelyou Road", Lat:40.640927, Lon:-73.963891, Synonyms:[]string{"Cortelyou Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D31", MTAName:"Newkirk Plaza", DisplayName:"Newkirk Plaza", PhoneticName:"Newkirk Plaza", Lat:40.635082, Lon:-73.962793, Synonyms:[]string{"Newkirk Plaza"}, Transfers:[]Transfer{Transfer{StopID:"D31", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D32", MTAName:"Avenue H", DisplayName:"Avenue H", PhoneticName:"Avenue H", Lat:40.62927, Lon:-73.961639, Synonyms:[]string{"Avenue H"}, Transfers:[]Transfer(nil)}, Stop{ID:"D33", MTAName:"Avenue J", DisplayName:"Avenue J", PhoneticName:"Avenue J", Lat:40.625039, Lon:-73.960803, Synonyms:[]string{"Avenue J"}, Transfers:[]Transfer(nil)}, Stop{ID:"D34", MTAName:"Avenue M", DisplayName:"Avenue M", PhoneticName:"Avenue M", Lat:40.617618, Lon:-73.959399, Synonyms:[]string{"Avenue M"}, Transfers:[]Transfer(nil)}, Stop{ID:"D35", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Lat:40.60867, Lon:-73.957734, Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"D35", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D37", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Lat:40.5993, Lon:-73.955929, Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer(nil)}, Stop{ID:"D38", MTAName:"Neck Rd", DisplayName:"Neck Road", PhoneticName:"Neck Road", Lat:40.595246, Lon:-73.955161, Synonyms:[]string{"Neck Road"}, Transfers:[]Transfer(nil)}, Stop{ID:"D39", MTAName:"Sheepshead Bay", DisplayName:"Sheepshead Bay", PhoneticName:"Sheepshead Bay", Lat:40.586896, Lon:-73.954155, Synonyms:[]string{"Sheepshead Bay"}, Transfers:[]Transfer{Transfer{StopID:"D39", Route:"B", Type:2, MinTransferSeconds:0}}}, Stop{ID:"D40", MTAName:"Brighton Beach", DisplayName:"Brighton Beach", PhoneticName:"Brighton Beach", Lat:40.577621, Lon:-73.961376, Synonyms:[]string{"Brighton Beach"}, Transfers:[]Transfer{Transfer{StopID:"D40", Route:"B", Type:2, MinTransferSeconds:300}}}, Stop{ID:"D41", MTAName:"Ocean Pkwy", DisplayName:"Ocean Parkway", PhoneticName:"Ocean Parkway", Lat:40.576312, Lon:-73.968501, Synonyms:[]string{"Ocean Parkway"}, Transfers:[]Transfer(nil)}, Stop{ID:"D42", MTAName:"W 8 St - NY Aquarium", DisplayName:"West 8th Street, NY Aquarium", PhoneticName:"West 8th Street, NY Aquarium", Lat:40.576127, Lon:-73.975939, Synonyms:[]string{"West 8th Street", "NY Aquarium", "NY Aquarium, West 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"D42", Route:"F", Type:2, MinTransferSeconds:180}}}, Stop{ID:"D43", MTAName:"Coney Island - Stillwell Av", DisplayName:"Coney Island - Stillwell Avenue", PhoneticName:"Coney Island, Stillwell Avenue", Lat:40.577422, Lon:-73.981233, Synonyms:[]string{"Coney Island", "Stillwell Avenue", "Stillwell Avenue, Coney Island", "Coney Island, Stillwell Avenue"}, Transfers:[]Transfer{Transfer{StopID:"D43", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D43", Route:"N", Type:2, MinTransferSeconds:180}}}}}, "R":Route{Name:"R", Northbound:"Queens", Southbound:"Brooklyn", Stops:[]Stop{Stop{ID:"G08", MTAName:"Forest Hills - 71 Av", DisplayName:"Forest Hills - 71st Avenue", PhoneticName:"Forest Hills, 71st Avenue", Lat:40.721691, Lon:-73.844521, Synonyms:[]string{"Forest Hills", "71st Avenue", "71st Avenue, Forest Hills", "Forest Hills, 71st Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G08", Route:"E", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"F", Type:2, MinTransferSeconds:0}, Transfer{StopID:"G08", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"G09", MTAName:"67 Av", DisplayName:"67th Avenue", PhoneticName:"67th Avenue", Lat:40.726523, Lon:-73.852719, Synonyms:[]string{"67th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G09", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G09", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G10", MTAName:"63 Dr - Rego Park", DisplayName:"63rd Dr - Rego Park", PhoneticName:"63rd Dr, Rego Park", Lat:40.729846, Lon:-73.861604, Synonyms:[]string{"63rd Dr", "Rego Park", "Rego Park, 63rd Dr", "63rd Dr, Rego Park"}, Transfers:[]Transfer{Transfer{StopID:"G10", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G10", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G11", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Lat:40.733106, Lon:-73.869229, Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G11", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G11", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G12", MTAName:"Grand Av - Newtown", DisplayName:"Grand Avenue, Newtown", PhoneticName:"Grand Avenue, Newtown", Lat:40.737015, Lon:-73.877223, Synonyms:[]string{"Grand Avenue", "Newtown", "Newtown, Grand Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G12", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G12", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G13", MTAName:"Elmhurst Av", DisplayName:"Elmhurst Avenue", PhoneticName:"Elmhurst Avenue", Lat:40.742454, Lon:-73.882017, Synonyms:[]string{"Elmhurst Avenue"}, Transfers:[]Transfer{Transfer{StopID:"G13", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G13", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G14", MTAName:"Jackson Hts - Roosevelt Av", DisplayName:"Jackson Heights - Roosevelt Avenue", PhoneticName:"Jackson Heights, Roosevelt Avenue", Lat:40.746644, Lon:-73.891338, Synonyms:[]string{"Jackson Heights", "Roosevelt Avenue", "Roosevelt Avenue, Jackson Heights", "Jackson Heights, Roosevelt Avenue"}, Transfers:[]Transfer{Transfer{StopID:"710", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G14", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G15", MTAName:"65 St", DisplayName:"65th Street", PhoneticName:"65th Street", Lat:40.749669, Lon:-73.898453, Synonyms:[]string{"65th Street"}, Transfers:[]Transfer{Transfer{StopID:"G15", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G15", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G16", MTAName:"Northern Blvd", DisplayName:"Northern Boulevard", PhoneticName:"Northern Boulevard", Lat:40.752885, Lon:-73.906006, Synonyms:[]string{"Northern Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"G16", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G16", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G18", MTAName:"46 St", DisplayName:"46th Street", PhoneticName:"46th Street", Lat:40.756312, Lon:-73.913333, Synonyms:[]string{"46th Street"}, Transfers:[]Transfer{Transfer{StopID:"G18", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G18", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G19", MTAName:"Steinway St", DisplayName:"Steinway Street", PhoneticName:"Steinway Street", Lat:40.756879, Lon:-73.92074, Synonyms:[]string{"Steinway Street"}, Transfers:[]Transfer{Transfer{StopID:"G19", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G19", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G20", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.752039, Lon:-73.928781, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"G20", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G20", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G21", MTAName:"Queens Plaza", DisplayName:"Queens Plaza", PhoneticName:"Queens Plaza", Lat:40.748973, Lon:-73.937243, Synonyms:[]string{"Queens Plaza"}, Transfers:[]Transfer{Transfer{StopID:"G21", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G21", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Lat:40.76266, Lon:-73.967258, Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Lat:40.764811, Lon:-73.973347, Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R13", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Lat:40.764664, Lon:-73.980658, Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Lat:40.759901, Lon:-73.984139, Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.754672, Lon:-73.986754, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749567, Lon:-73.98795, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.745494, Lon:-73.988691, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.741303, Lon:-73.989344, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.735736, Lon:-73.990568, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Lat:40.730328, Lon:-73.992629, Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Lat:40.724329, Lon:-73.997702, Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.719527, Lon:-74.001775, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Lat:40.713282, Lon:-74.006978, Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R24", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Lat:40.710668, Lon:-74.011029, Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:420}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:420}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:240}, Transfer{StopID:"R25", Route:"N", Type:0, MinTransferSeconds:0}, Transfer{StopID:"R25", Route:"W", Type:0, MinTransferSeconds:0}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Lat:40.70722, Lon:-74.013342, Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R26", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Lat:40.703087, Lon:-74.012994, Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R27", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Lat:40.6941, Lon:-73.991777, Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.69218, Lon:-73.985942, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"C", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"F", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.683666, Lon:-73.97881, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Lat:40.677316, Lon:-73.98311, Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670847, Lon:-73.988302, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.665414, Lon:-73.992872, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Lat:40.660397, Lon:-73.998091, Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.655144, Lon:-74.003549, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Lat:40.648939, Lon:-74.010006, Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R39", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Lat:40.645069, Lon:-74.014034, Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R40", Route:"W", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.641362, Lon:-74.017881, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R41", Route:"W", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R42", MTAName:"Bay Ridge Av", DisplayName:"Bay Ridge Avenue", PhoneticName:"Bay Ridge Avenue", Lat:40.634967, Lon:-74.023377, Synonyms:[]string{"Bay Ridge Avenue"}, Transfers:[]Transfer(nil)}, Stop{ID:"R43", MTAName:"77 St", DisplayName:"77th Street", PhoneticName:"77th Street", Lat:40.629742, Lon:-74.02551, Synonyms:[]string{"77th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"R44", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.622687, Lon:-74.028398, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer(nil)}, Stop{ID:"R45", MTAName:"Bay Ridge - 95 St", DisplayName:"Bay Ridge - 95th Street", PhoneticName:"Bay Ridge, 95th Street", Lat:40.616622, Lon:-74.030876, Synonyms:[]string{"Bay Ridge", "95th Street", "95th Street, Bay Ridge", "Bay Ridge, 95th Street"}, Transfers:[]Transfer(nil)}}}, "W":Route{Name:"W", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"R01", MTAName:"Astoria - Ditmars Blvd", DisplayName:"Astoria - Ditmars Boulevard", PhoneticName:"Astoria, Ditmars Boulevard", Lat:40.775036, Lon:-73.912034, Synonyms:[]string{"Astoria", "Ditmars Boulevard", "Ditmars Boulevard, Astoria", "Astoria, Ditmars Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R01", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R03", MTAName:"Astoria Blvd", DisplayName:"Astoria Boulevard", PhoneticName:"Astoria Boulevard", Lat:40.770258, Lon:-73.917843, Synonyms:[]string{"Astoria Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"R03", Route:"N", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R04", MTAName:"30 Av", DisplayName:"30th Avenue", PhoneticName:"30th Avenue", Lat:40.766779, Lon:-73.921479, Synonyms:[]string{"30th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R04", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R05", MTAName:"Broadway", DisplayName:"Broadway", PhoneticName:"Broadway", Lat:40.76182, Lon:-73.925508, Synonyms:[]string{"Broadway"}, Transfers:[]Transfer{Transfer{StopID:"R05", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R06", MTAName:"36 Av", DisplayName:"36th Avenue", PhoneticName:"36th Avenue", Lat:40.756804, Lon:-73.929575, Synonyms:[]string{"36th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R06", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R08", MTAName:"39 Av - Dutch Kills", DisplayName:"39th Avenue, Dutch Kills", PhoneticName:"39th Avenue, Dutch Kills", Lat:40.752882, Lon:-73.932755, Synonyms:[]string{"39th Avenue", "Dutch Kills", "Dutch Kills, 39th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R08", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R09", MTAName:"Queensboro Plaza", DisplayName:"Queensboro Plaza", PhoneticName:"Queensboro Plaza", Lat:40.750582, Lon:-73.940202, Synonyms:[]string{"Queensboro Plaza"}, Transfers:[]Transfer{Transfer{StopID:"718", Route:"7", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R09", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R11", MTAName:"Lexington Av/59 St", DisplayName:"Lexington Avenue, 59th Street", PhoneticName:"Lexington Avenue, 59th Street", Lat:40.76266, Lon:-73.967258, Synonyms:[]string{"Lexington Avenue", "59th Street", "59th Street, Lexington Avenue"}, Transfers:[]Transfer{Transfer{StopID:"629", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"629", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"B08", Route:"F", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"B08", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R11", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R13", MTAName:"5 Av/59 St", DisplayName:"5th Avenue, 59th Street", PhoneticName:"5th Avenue, 59th Street", Lat:40.764811, Lon:-73.973347, Synonyms:[]string{"5th Avenue", "59th Street", "59th Street, 5th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R13", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R13", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R14", MTAName:"57 St - 7 Av", DisplayName:"57th Street, 7th Avenue", PhoneticName:"57th Street, 7th Avenue", Lat:40.764664, Lon:-73.980658, Synonyms:[]string{"57th Street", "7th Avenue", "7th Avenue, 57th Street"}, Transfers:[]Transfer{Transfer{StopID:"R14", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R14", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R15", MTAName:"49 St", DisplayName:"49th Street", PhoneticName:"49th Street", Lat:40.759901, Lon:-73.984139, Synonyms:[]string{"49th Street"}, Transfers:[]Transfer{Transfer{StopID:"R15", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R15", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R16", MTAName:"Times Sq - 42 St", DisplayName:"Times Square - 42nd Street", PhoneticName:"Times Square, 42nd Street", Lat:40.754672, Lon:-73.986754, Synonyms:[]string{"Times Square", "42nd Street", "42nd Street, Times Square", "Times Square, 42nd Street"}, Transfers:[]Transfer{Transfer{StopID:"127", Route:"1", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"127", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"725", Route:"7", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A27", Route:"A", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"C", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A27", Route:"E", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R16", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R16", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R17", MTAName:"34 St - Herald Sq", DisplayName:"34th Street, Herald Square", PhoneticName:"34th Street, Herald Square", Lat:40.749567, Lon:-73.98795, Synonyms:[]string{"34th Street", "Herald Square", "Herald Square, 34th Street"}, Transfers:[]Transfer{Transfer{StopID:"D17", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D17", Route:"M", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R17", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R17", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R18", MTAName:"28 St", DisplayName:"28th Street", PhoneticName:"28th Street", Lat:40.745494, Lon:-73.988691, Synonyms:[]string{"28th Street"}, Transfers:[]Transfer{Transfer{StopID:"R18", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R18", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R19", MTAName:"23 St", DisplayName:"23rd Street", PhoneticName:"23rd Street", Lat:40.741303, Lon:-73.989344, Synonyms:[]string{"23rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R19", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R19", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R20", MTAName:"14 St - Union Sq", DisplayName:"14th Street, Union Square", PhoneticName:"14th Street, Union Square", Lat:40.735736, Lon:-73.990568, Synonyms:[]string{"14th Street", "Union Square", "Union Square, 14th Street"}, Transfers:[]Transfer{Transfer{StopID:"635", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"635", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"L03", Route:"L", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R20", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"Q", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R20", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R21", MTAName:"8 St - NYU", DisplayName:"8th Street, NYU", PhoneticName:"8th Street, NYU", Lat:40.730328, Lon:-73.992629, Synonyms:[]string{"8th Street", "NYU", "NYU, 8th Street"}, Transfers:[]Transfer{Transfer{StopID:"R21", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R21", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R22", MTAName:"Prince St", DisplayName:"Prince Street", PhoneticName:"Prince Street", Lat:40.724329, Lon:-73.997702, Synonyms:[]string{"Prince Street"}, Transfers:[]Transfer{Transfer{StopID:"R22", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R22", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R23", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.719527, Lon:-74.001775, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"Z", Type:2, MinTransferSeconds:300}}}, Stop{ID:"R24", MTAName:"City Hall", DisplayName:"City Hall", PhoneticName:"City Hall", Lat:40.713282, Lon:-74.006978, Synonyms:[]string{"City Hall"}, Transfers:[]Transfer{Transfer{StopID:"R24", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R24", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R25", MTAName:"Cortlandt St", DisplayName:"Cortlandt Street", PhoneticName:"Cortlandt Street", Lat:40.710668, Lon:-74.011029, Synonyms:[]string{"Cortlandt Street"}, Transfers:[]Transfer{Transfer{StopID:"228", Route:"2", Type:2, MinTransferSeconds:420}, Transfer{StopID:"228", Route:"3", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"A", Type:2, MinTransferSeconds:420}, Transfer{StopID:"A36", Route:"C", Type:2, MinTransferSeconds:420}, Transfer{StopID:"E01", Route:"E", Type:2, MinTransferSeconds:240}, Transfer{StopID:"R25", Route:"N", Type:0, MinTransferSeconds:0}, Transfer{StopID:"R25", Route:"R", Type:0, MinTransferSeconds:0}}}, Stop{ID:"R26", MTAName:"Rector St", DisplayName:"Rector Street", PhoneticName:"Rector Street", Lat:40.70722, Lon:-74.013342, Synonyms:[]string{"Rector Street"}, Transfers:[]Transfer{Transfer{StopID:"R26", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R26", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R27", MTAName:"Whitehall St - South Ferry", DisplayName:"Whitehall Street, South Ferry", PhoneticName:"Whitehall Street, South Ferry", Lat:40.703087, Lon:-74.012994, Synonyms:[]string{"Whitehall Street", "South Ferry", "South Ferry, Whitehall Street"}, Transfers:[]Transfer{Transfer{StopID:"R27", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R27", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R28", MTAName:"Court St", DisplayName:"Court Street", PhoneticName:"Court Street", Lat:40.6941, Lon:-73.991777, Synonyms:[]string{"Court Street"}, Transfers:[]Transfer{Transfer{StopID:"232", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"232", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"423", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R28", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R29", MTAName:"Jay St - MetroTech", DisplayName:"Jay Street, MetroTech", PhoneticName:"Jay Street, MetroTech", Lat:40.69218, Lon:-73.985942, Synonyms:[]string{"Jay Street", "MetroTech", "MetroTech, Jay Street"}, Transfers:[]Transfer{Transfer{StopID:"A41", Route:"A", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"C", Type:2, MinTransferSeconds:90}, Transfer{StopID:"A41", Route:"F", Type:2, MinTransferSeconds:90}, Transfer{StopID:"R29", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R29", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R30", MTAName:"DeKalb Av", DisplayName:"DeKalb Avenue", PhoneticName:"DeKalb Avenue", Lat:40.690635, Lon:-73.981824, Synonyms:[]string{"DeKalb Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R30", Route:"B", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R30", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R31", MTAName:"Atlantic Av - Barclays Ctr", DisplayName:"Atlantic Avenue, Barclays Center", PhoneticName:"Atlantic Avenue, Barclays Center", Lat:40.683666, Lon:-73.97881, Synonyms:[]string{"Atlantic Avenue", "Barclays Center", "Barclays Center, Atlantic Avenue"}, Transfers:[]Transfer{Transfer{StopID:"235", Route:"2", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"3", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"235", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"D24", Route:"B", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R31", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"D24", Route:"Q", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R31", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R32", MTAName:"Union St", DisplayName:"Union Street", PhoneticName:"Union Street", Lat:40.677316, Lon:-73.98311, Synonyms:[]string{"Union Street"}, Transfers:[]Transfer{Transfer{StopID:"R32", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R32", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R33", MTAName:"4 Av - 9 St", DisplayName:"4th Avenue, 9th Street", PhoneticName:"4th Avenue, 9th Street", Lat:40.670847, Lon:-73.988302, Synonyms:[]string{"4th Avenue", "9th Street", "9th Street, 4th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R33", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"F23", Route:"G", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R33", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R34", MTAName:"Prospect Av", DisplayName:"Prospect Avenue", PhoneticName:"Prospect Avenue", Lat:40.665414, Lon:-73.992872, Synonyms:[]string{"Prospect Avenue"}, Transfers:[]Transfer{Transfer{StopID:"R34", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R34", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R35", MTAName:"25 St", DisplayName:"25th Street", PhoneticName:"25th Street", Lat:40.660397, Lon:-73.998091, Synonyms:[]string{"25th Street"}, Transfers:[]Transfer{Transfer{StopID:"R35", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R35", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R36", MTAName:"36 St", DisplayName:"36th Street", PhoneticName:"36th Street", Lat:40.655144, Lon:-74.003549, Synonyms:[]string{"36th Street"}, Transfers:[]Transfer{Transfer{StopID:"R36", Route:"D", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R36", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"R39", MTAName:"45 St", DisplayName:"45th Street", PhoneticName:"45th Street", Lat:40.648939, Lon:-74.010006, Synonyms:[]string{"45th Street"}, Transfers:[]Transfer{Transfer{StopID:"R39", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R39", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R40", MTAName:"53 St", DisplayName:"53rd Street", PhoneticName:"53rd Street", Lat:40.645069, Lon:-74.014034, Synonyms:[]string{"53rd Street"}, Transfers:[]Transfer{Transfer{StopID:"R40", Route:"N", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R40", Route:"R", Type:2, MinTransferSeconds:180}}}, Stop{ID:"R41", MTAName:"59 St", DisplayName:"59th Street", PhoneticName:"59th Street", Lat:40.641362, Lon:-74.017881, Synonyms:[]string{"59th Street"}, Transfers:[]Transfer{Transfer{StopID:"R41", Route:"N", Type:2, MinTransferSeconds:0}, Transfer{StopID:"R41", Route:"R", Type:2, MinTransferSeconds:0}}}, Stop{ID:"N02", MTAName:"8 Av", DisplayName:"8th Avenue", PhoneticName:"8th Avenue", Lat:40.635064, Lon:-74.011719, Synonyms:[]string{"8th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N02", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N03", MTAName:"Fort Hamilton Pkwy", DisplayName:"Fort Hamilton Parkway", PhoneticName:"Fort Hamilton Parkway", Lat:40.631386, Lon:-74.005351, Synonyms:[]string{"Fort Hamilton Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N03", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N04", MTAName:"New Utrecht Av", DisplayName:"New Utrecht Avenue", PhoneticName:"New Utrecht Avenue", Lat:40.624842, Lon:-73.996353, Synonyms:[]string{"New Utrecht Avenue"}, Transfers:[]Transfer{Transfer{StopID:"B16", Route:"D", Type:2, MinTransferSeconds:180}, Transfer{StopID:"N04", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N05", MTAName:"18 Av", DisplayName:"18th Avenue", PhoneticName:"18th Avenue", Lat:40.620671, Lon:-73.990414, Synonyms:[]string{"18th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N05", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N06", MTAName:"20 Av", DisplayName:"20th Avenue", PhoneticName:"20th Avenue", Lat:40.61741, Lon:-73.985026, Synonyms:[]string{"20th Avenue"}, Transfers:[]Transfer{Transfer{StopID:"N06", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N07", MTAName:"Bay Pkwy", DisplayName:"Bay Parkway", PhoneticName:"Bay Parkway", Lat:40.611815, Lon:-73.981848, Synonyms:[]string{"Bay Parkway"}, Transfers:[]Transfer{Transfer{StopID:"N07", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N08", MTAName:"Kings Hwy", DisplayName:"Kings Highway", PhoneticName:"Kings Highway", Lat:40.603923, Lon:-73.980353, Synonyms:[]string{"Kings Highway"}, Transfers:[]Transfer{Transfer{StopID:"N08", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N09", MTAName:"Avenue U", DisplayName:"Avenue U", PhoneticName:"Avenue U", Lat:40.597473, Lon:-73.979137, Synonyms:[]string{"Avenue U"}, Transfers:[]Transfer{Transfer{StopID:"N09", Route:"N", Type:2, MinTransferSeconds:180}}}, Stop{ID:"N10", MTAName:"86 St", DisplayName:"86th Street", PhoneticName:"86th Street", Lat:40.592721, Lon:-73.97823, Synonyms:[]string{"86th Street"}, Transfers:[]Transfer{Transfer{StopID:"N10", Route:"N", Type:2, MinTransferSeconds:180}}}}}, "Z":Route{Name:"Z", Northbound:"Queens", Southbound:"Manhattan", Stops:[]Stop{Stop{ID:"G05", MTAName:"Jamaica Center - Parsons/Archer", DisplayName:"Jamaica Center - Parsons, Archer", PhoneticName:"Jamaica Center, Parsons, Archer", Lat:40.702147, Lon:-73.801109, Synonyms:[]string{"Jamaica Center", "Parsons", "Archer", "Jamaica Center, Parsons, Archer"}, Transfers:[]Transfer{Transfer{StopID:"G05", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G05", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"G06", MTAName:"Sutphin Blvd - Archer Av - JFK Airport", DisplayName:"Sutphin Boulevard - Archer Avenue, JFK Airport", PhoneticName:"Sutphin Boulevard, Archer Avenue, JFK Airport", Lat:40.700486, Lon:-73.807969, Synonyms:[]string{"Sutphin Boulevard", "Archer Avenue", "JFK Airport", "Sutphin Boulevard, Archer Avenue, JFK Airport"}, Transfers:[]Transfer{Transfer{StopID:"G06", Route:"E", Type:2, MinTransferSeconds:180}, Transfer{StopID:"G06", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J12", MTAName:"121 St", DisplayName:"121st Street", PhoneticName:"121st Street", Lat:40.700492, Lon:-73.828294, Synonyms:[]string{"121st Street"}, Transfers:[]Transfer{Transfer{StopID:"J12", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J14", MTAName:"104 St", DisplayName:"104th Street", PhoneticName:"104th Street", Lat:40.695178, Lon:-73.84433, Synonyms:[]string{"104th Street"}, Transfers:[]Transfer{Transfer{StopID:"J14", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J15", MTAName:"Woodhaven Blvd", DisplayName:"Woodhaven Boulevard", PhoneticName:"Woodhaven Boulevard", Lat:40.693879, Lon:-73.851576, Synonyms:[]string{"Woodhaven Boulevard"}, Transfers:[]Transfer{Transfer{StopID:"J15", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J17", MTAName:"75 St - Elder Ln", DisplayName:"75th Street, Elder Ln", PhoneticName:"75th Street, Elder Ln", Lat:40.691324, Lon:-73.867139, Synonyms:[]string{"75th Street", "Elder Ln", "Elder Ln, 75th Street"}, Transfers:[]Transfer{Transfer{StopID:"J17", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J20", MTAName:"Crescent St", DisplayName:"Crescent Street", PhoneticName:"Crescent Street", Lat:40.683194, Lon:-73.873785, Synonyms:[]string{"Crescent Street"}, Transfers:[]Transfer{Transfer{StopID:"J20", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J21", MTAName:"Norwood Av", DisplayName:"Norwood Avenue", PhoneticName:"Norwood Avenue", Lat:40.68141, Lon:-73.880039, Synonyms:[]string{"Norwood Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J21", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J23", MTAName:"Van Siclen Av", DisplayName:"Van Siclen Avenue", PhoneticName:"Van Siclen Avenue", Lat:40.678024, Lon:-73.891688, Synonyms:[]string{"Van Siclen Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J23", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J24", MTAName:"Alabama Av", DisplayName:"Alabama Avenue", PhoneticName:"Alabama Avenue", Lat:40.676992, Lon:-73.898654, Synonyms:[]string{"Alabama Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J24", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J27", MTAName:"Broadway Jct", DisplayName:"Broadway Junction", PhoneticName:"Broadway Junction", Lat:40.679498, Lon:-73.904512, Synonyms:[]string{"Broadway Junction"}, Transfers:[]Transfer{Transfer{StopID:"A51", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A51", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"J27", Route:"J", Type:2, MinTransferSeconds:0}, Transfer{StopID:"L22", Route:"L", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J28", MTAName:"Chauncey St", DisplayName:"Chauncey Street", PhoneticName:"Chauncey Street", Lat:40.682893, Lon:-73.910456, Synonyms:[]string{"Chauncey Street"}, Transfers:[]Transfer{Transfer{StopID:"J28", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"J30", MTAName:"Gates Av", DisplayName:"Gates Avenue", PhoneticName:"Gates Avenue", Lat:40.68963, Lon:-73.92227, Synonyms:[]string{"Gates Avenue"}, Transfers:[]Transfer{Transfer{StopID:"J30", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M11", MTAName:"Myrtle Av", DisplayName:"Myrtle Avenue", PhoneticName:"Myrtle Avenue", Lat:40.697207, Lon:-73.935657, Synonyms:[]string{"Myrtle Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M11", Route:"J", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M11", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M16", MTAName:"Marcy Av", DisplayName:"Marcy Avenue", PhoneticName:"Marcy Avenue", Lat:40.708359, Lon:-73.957757, Synonyms:[]string{"Marcy Avenue"}, Transfers:[]Transfer{Transfer{StopID:"M16", Route:"J", Type:2, MinTransferSeconds:0}, Transfer{StopID:"M16", Route:"M", Type:2, MinTransferSeconds:0}}}, Stop{ID:"M18", MTAName:"Delancy St - Essex St", DisplayName:"Delancy Street, Essex Street", PhoneticName:"Delancy Street, Essex Street", Lat:40.718315, Lon:-73.987437, Synonyms:[]string{"Delancy Street", "Essex Street", "Essex Street, Delancy Street"}, Transfers:[]Transfer{Transfer{StopID:"F15", Route:"F", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M18", Route:"M", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M19", MTAName:"Bowery", DisplayName:"Bowery", PhoneticName:"Bowery", Lat:40.72028, Lon:-73.993915, Synonyms:[]string{"Bowery"}, Transfers:[]Transfer{Transfer{StopID:"M19", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M20", MTAName:"Canal St", DisplayName:"Canal Street", PhoneticName:"Canal Street", Lat:40.718092, Lon:-73.999892, Synonyms:[]string{"Canal Street"}, Transfers:[]Transfer{Transfer{StopID:"639", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"639", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M20", Route:"J", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"N", Type:2, MinTransferSeconds:300}, Transfer{StopID:"Q01", Route:"Q", Type:2, MinTransferSeconds:180}, Transfer{StopID:"R23", Route:"R", Type:2, MinTransferSeconds:300}, Transfer{StopID:"R23", Route:"W", Type:2, MinTransferSeconds:300}}}, Stop{ID:"M21", MTAName:"Chambers St", DisplayName:"Chambers Street", PhoneticName:"Chambers Street", Lat:40.713243, Lon:-74.003401, Synonyms:[]string{"Chambers Street"}, Transfers:[]Transfer{Transfer{StopID:"640", Route:"4", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"5", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"5X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"6", Type:2, MinTransferSeconds:180}, Transfer{StopID:"640", Route:"6X", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M21", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M22", MTAName:"Fulton St", DisplayName:"Fulton Street", PhoneticName:"Fulton Street", Lat:40.710374, Lon:-74.007582, Synonyms:[]string{"Fulton Street"}, Transfers:[]Transfer{Transfer{StopID:"229", Route:"2", Type:2, MinTransferSeconds:300}, Transfer{StopID:"229", Route:"3", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"4", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5", Type:2, MinTransferSeconds:300}, Transfer{StopID:"418", Route:"5X", Type:2, MinTransferSeconds:300}, Transfer{StopID:"A38", Route:"A", Type:2, MinTransferSeconds:180}, Transfer{StopID:"A38", Route:"C", Type:2, MinTransferSeconds:180}, Transfer{StopID:"M22", Route:"J", Type:2, MinTransferSeconds:180}}}, Stop{ID:"M23", MTAName:"Broad St", DisplayName:"Broad Street", PhoneticName:"Broad Street", Lat:40.706476, Lon:-74.011056, Synonyms:[]string{"Broad Street"}, Transfers:[]Transfer{Transfer{StopID:"M23", Route:"J", Type:2, MinTransferSeconds:180}}}}}}
@@ -0,0 +1,151 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvColumns = []string{
+	"route_id",
+	"direction",
+	"station_id",
+	"hour",
+	"observations",
+	"scheduled",
+	"on_time",
+	"on_time_performance",
+	"headways",
+	"headways_met",
+	"wait_assessment",
+	"excess_wait_seconds",
+	"scheduled_stops",
+	"completed_stops",
+	"trip_completion",
+}
+
+// WriteCSV will write a report to w as CSV with a row per group. Rates with
+// nothing to measure are left empty.
+func WriteCSV(w io.Writer, r Report) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return fmt.Errorf("%w: unable to write report", err)
+	}
+	for _, m := range r.Rows {
+		var hour string
+		if m.Hour >= 0 {
+			hour = strconv.Itoa(m.Hour)
+		}
+		var excess string
+		if m.Headways > 0 {
+			excess = strconv.Itoa(int(m.ExcessWait / time.Second))
+		}
+		err := cw.Write([]string{
+			m.RouteID,
+			m.Direction,
+			m.StationID,
+			hour,
+			strconv.Itoa(m.Observations),
+			strconv.Itoa(m.Scheduled),
+			strconv.Itoa(m.OnTime),
+			rate(m.OnTimePerformance, m.Scheduled),
+			strconv.Itoa(m.Headways),
+			strconv.Itoa(m.HeadwaysMet),
+			rate(m.WaitAssessment, m.Headways),
+			excess,
+			strconv.Itoa(m.ScheduledStops),
+			strconv.Itoa(m.CompletedStops),
+			rate(m.TripCompletion, m.ScheduledStops),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: unable to write report", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("%w: unable to write report", err)
+	}
+	return nil
+}
+
+func rate(r float64, n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(r, 'f', 4, 64)
+}
+
+type jsonReport struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	By   string    `json:"by"`
+
+	Rows []jsonRow `json:"rows"`
+}
+
+// jsonRow leaves out the fields a report is not grouped by and the rates with
+// nothing to measure.
+type jsonRow struct {
+	RouteID   string `json:"route_id,omitempty"`
+	Direction string `json:"direction,omitempty"`
+	StationID string `json:"station_id,omitempty"`
+	Hour      *int   `json:"hour,omitempty"`
+
+	Observations int `json:"observations"`
+
+	Scheduled         int      `json:"scheduled"`
+	OnTime            int      `json:"on_time"`
+	OnTimePerformance *float64 `json:"on_time_performance,omitempty"`
+
+	Headways          int      `json:"headways"`
+	HeadwaysMet       int      `json:"headways_met"`
+	WaitAssessment    *float64 `json:"wait_assessment,omitempty"`
+	ExcessWaitSeconds *int     `json:"excess_wait_seconds,omitempty"`
+
+	ScheduledStops int      `json:"scheduled_stops"`
+	CompletedStops int      `json:"completed_stops"`
+	TripCompletion *float64 `json:"trip_completion,omitempty"`
+}
+
+// WriteJSON will encode a report to w.
+func WriteJSON(w io.Writer, r Report) error {
+	out := jsonReport{From: r.From, To: r.To, By: r.By.String(), Rows: []jsonRow{}}
+	for _, m := range r.Rows {
+		row := jsonRow{
+			RouteID:        m.RouteID,
+			Direction:      m.Direction,
+			StationID:      m.StationID,
+			Observations:   m.Observations,
+			Scheduled:      m.Scheduled,
+			OnTime:         m.OnTime,
+			Headways:       m.Headways,
+			HeadwaysMet:    m.HeadwaysMet,
+			ScheduledStops: m.ScheduledStops,
+			CompletedStops: m.CompletedStops,
+		}
+		if m.Hour >= 0 {
+			hour := m.Hour
+			row.Hour = &hour
+		}
+		if m.Scheduled > 0 {
+			otp := m.OnTimePerformance
+			row.OnTimePerformance = &otp
+		}
+		if m.Headways > 0 {
+			wa, excess := m.WaitAssessment, int(m.ExcessWait/time.Second)
+			row.WaitAssessment, row.ExcessWaitSeconds = &wa, &excess
+		}
+		if m.ScheduledStops > 0 {
+			tc := m.TripCompletion
+			row.TripCompletion = &tc
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		return fmt.Errorf("%w: unable to write report", err)
+	}
+	return nil
+}
@@ -0,0 +1,370 @@
+// Package report computes service reliability metrics from train
+// observations made by mta.Recorder, using the static schedule as the
+// baseline.
+package report
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs/mta"
+	"github.com/jprobinson/gtfs/static"
+)
+
+var (
+	// OnTimeEarly and OnTimeLate bound how far from the schedule a train may
+	// stop and still be on time.
+	OnTimeEarly = time.Minute
+	OnTimeLate  = 5 * time.Minute
+
+	// WaitAssessmentFactor is how much longer than scheduled a headway may be
+	// and still meet the wait assessment standard.
+	WaitAssessmentFactor = 1.25
+)
+
+// scheduleMargin is how far outside a report's period scheduled times are
+// loaded so headways at its edges have a baseline.
+const scheduleMargin = 3 * time.Hour
+
+// Dimension is a set of fields to group a report by.
+type Dimension int
+
+const (
+	ByRoute Dimension = 1 << iota
+	ByDirection
+	ByStation
+	ByHour
+)
+
+var dimensionNames = []struct {
+	dim  Dimension
+	name string
+}{
+	{ByRoute, "route"},
+	{ByDirection, "direction"},
+	{ByStation, "station"},
+	{ByHour, "hour"},
+}
+
+func (d Dimension) String() string {
+	var names []string
+	for _, dn := range dimensionNames {
+		if d&dn.dim != 0 {
+			names = append(names, dn.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseDimension will parse a comma separated list of dimensions like
+// "route,direction,hour".
+func ParseDimension(s string) (Dimension, error) {
+	var d Dimension
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, dn := range dimensionNames {
+			if dn.name == name {
+				d |= dn.dim
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown report dimension %q", name)
+		}
+	}
+	return d, nil
+}
+
+// Key identifies a row of a report. Fields the report is not grouped by are
+// empty, or -1 for Hour.
+type Key struct {
+	RouteID string
+	// Direction is "N" or "S".
+	Direction string
+	// StationID is the parent station, like "127".
+	StationID string
+	// Hour is the hour of the day in the feed's timezone.
+	Hour int
+}
+
+// Metrics are the measures of service for a row of a report. Rates are zero
+// when their counts are.
+type Metrics struct {
+	Key
+
+	Observations int
+
+	// Scheduled is the number of observations matched to the schedule and
+	// OnTime those stopping within OnTimeEarly and OnTimeLate of it.
+	Scheduled         int
+	OnTime            int
+	OnTimePerformance float64
+
+	// Headways is the number of observed headways with a scheduled headway to
+	// compare against and HeadwaysMet those no longer than the scheduled one
+	// times WaitAssessmentFactor.
+	Headways       int
+	HeadwaysMet    int
+	WaitAssessment float64
+	// ExcessWait is how much longer riders showing up at random waited than
+	// the schedule would have had them wait.
+	ExcessWait time.Duration
+
+	// ScheduledStops is the number of stops trains were scheduled to make in
+	// the report's period and CompletedStops those a matched train was
+	// observed making.
+	ScheduledStops int
+	CompletedStops int
+	TripCompletion float64
+
+	// sums of observed and scheduled headways and their squares, in seconds
+	actualSum, actualSq float64
+	schedSum, schedSq   float64
+}
+
+// Report is a set of metrics grouped by some dimensions.
+type Report struct {
+	From time.Time
+	To   time.Time
+	By   Dimension
+
+	// Rows are ordered by route, direction, station and hour.
+	Rows []Metrics
+}
+
+// New will compute a report over the observations made from from up to to.
+// Trip completion needs observations matched to the static schedule, so it
+// will be zero for observations recorded without a TripMatcher.
+func New(feed *static.Feed, obs []mta.Observation, from, to time.Time, by Dimension) Report {
+	r := &builder{
+		feed:   feed,
+		by:     by,
+		loc:    feed.Location,
+		groups: map[Key]*Metrics{},
+		times:  map[string][]int64{},
+	}
+	if r.loc == nil {
+		r.loc = time.UTC
+	}
+
+	var inRange []mta.Observation
+	for _, o := range obs {
+		if t := o.Time(); !t.Before(from) && t.Before(to) {
+			inRange = append(inRange, o)
+		}
+	}
+	r.onTime(inRange)
+	r.loadSchedule(inRange, from, to)
+	r.headways(inRange)
+
+	rep := Report{From: from, To: to, By: by}
+	for _, m := range r.groups {
+		m.finish()
+		rep.Rows = append(rep.Rows, *m)
+	}
+	sort.Slice(rep.Rows, func(i, j int) bool {
+		a, b := rep.Rows[i].Key, rep.Rows[j].Key
+		if a.RouteID != b.RouteID {
+			return a.RouteID < b.RouteID
+		}
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		if a.StationID != b.StationID {
+			return a.StationID < b.StationID
+		}
+		return a.Hour < b.Hour
+	})
+	return rep
+}
+
+type builder struct {
+	feed   *static.Feed
+	by     Dimension
+	loc    *time.Location
+	groups map[Key]*Metrics
+
+	// route|stop ID => scheduled unix times around the report's period,
+	// sorted, for platforms with observations
+	times map[string][]int64
+}
+
+// loadSchedule walks the scheduled stops around the report's period, keeping
+// the times at observed platforms for headways and counting the stops made
+// within the period toward trip completion.
+func (r *builder) loadSchedule(obs []mta.Observation, from, to time.Time) {
+	served := map[string]bool{}
+	for _, o := range obs {
+		r.times[o.RouteID+"|"+o.StopID] = nil
+		if o.StaticTripID == "" || o.ServiceDate.IsZero() {
+			continue
+		}
+		served[o.StaticTripID+"|"+o.StopID+"|"+o.ServiceDate.Format(static.DateFormat)] = true
+	}
+
+	first, last := from.Add(-scheduleMargin), to.Add(scheduleMargin)
+	// service days can run up to 48 hours
+	for day := r.feed.ServiceDate(first).AddDate(0, 0, -2); !day.After(last); day = day.AddDate(0, 0, 1) {
+		date := day.Format(static.DateFormat)
+		for _, trip := range r.feed.TripsOn(day, "") {
+			for _, st := range r.feed.StopTimes[trip.ID] {
+				t := st.DepartureTime
+				if t == static.NoTime {
+					t = st.ArrivalTime
+				}
+				if t == static.NoTime {
+					continue
+				}
+				at := t.On(day)
+				if at.Before(first) || !at.Before(last) {
+					continue
+				}
+				key := trip.RouteID + "|" + st.StopID
+				if times, ok := r.times[key]; ok {
+					r.times[key] = append(times, at.Unix())
+				}
+				if at.Before(from) || !at.Before(to) {
+					continue
+				}
+				m := r.group(trip.RouteID, st.StopID, at)
+				m.ScheduledStops++
+				if served[trip.ID+"|"+st.StopID+"|"+date] {
+					m.CompletedStops++
+				}
+			}
+		}
+	}
+	for _, times := range r.times {
+		sort.Slice(times, func(i, j int) bool {
+			return times[i] < times[j]
+		})
+	}
+}
+
+func (r *builder) onTime(obs []mta.Observation) {
+	for _, o := range obs {
+		m := r.group(o.RouteID, o.StopID, o.Time())
+		m.Observations++
+		if o.Scheduled().IsZero() {
+			continue
+		}
+		m.Scheduled++
+		if o.Delay >= -OnTimeEarly && o.Delay <= OnTimeLate {
+			m.OnTime++
+		}
+	}
+}
+
+// headways compares the time between consecutive trains at each platform
+// with the scheduled time between them. A headway counts toward the group of
+// the train ending it.
+func (r *builder) headways(obs []mta.Observation) {
+	byStop := map[string][]mta.Observation{}
+	for _, o := range obs {
+		key := o.RouteID + "|" + o.StopID
+		byStop[key] = append(byStop[key], o)
+	}
+	for key, stopObs := range byStop {
+		sort.SliceStable(stopObs, func(i, j int) bool {
+			return stopObs[i].Time().Before(stopObs[j].Time())
+		})
+		for i := 1; i < len(stopObs); i++ {
+			lead, next := stopObs[i-1], stopObs[i]
+			// the schedule is the baseline for when the following train was
+			// due, or when it came if it ran unscheduled
+			due := next.Scheduled()
+			if due.IsZero() {
+				due = next.Time()
+			}
+			sched := scheduledHeadway(r.times[key], due)
+			if sched <= 0 {
+				continue
+			}
+			actual := next.Time().Sub(lead.Time())
+
+			m := r.group(next.RouteID, next.StopID, next.Time())
+			m.Headways++
+			if float64(actual) <= WaitAssessmentFactor*float64(sched) {
+				m.HeadwaysMet++
+			}
+			m.actualSum += actual.Seconds()
+			m.actualSq += actual.Seconds() * actual.Seconds()
+			m.schedSum += sched.Seconds()
+			m.schedSq += sched.Seconds() * sched.Seconds()
+		}
+	}
+}
+
+// group returns the metrics for the row a stop falls in.
+func (r *builder) group(routeID, stopID string, t time.Time) *Metrics {
+	key := Key{Hour: -1}
+	if r.by&ByRoute != 0 {
+		key.RouteID = routeID
+	}
+	if r.by&ByDirection != 0 {
+		if n := len(stopID); n > 1 && (stopID[n-1] == 'N' || stopID[n-1] == 'S') {
+			key.Direction = stopID[n-1:]
+		}
+	}
+	if r.by&ByStation != 0 {
+		key.StationID = r.station(stopID)
+	}
+	if r.by&ByHour != 0 {
+		key.Hour = t.In(r.loc).Hour()
+	}
+
+	m, ok := r.groups[key]
+	if !ok {
+		m = &Metrics{Key: key}
+		r.groups[key] = m
+	}
+	return m
+}
+
+func (r *builder) station(stopID string) string {
+	if parent := r.feed.Stops[stopID].ParentStation; parent != "" {
+		return parent
+	}
+	if n := len(stopID); n > 1 && (stopID[n-1] == 'N' || stopID[n-1] == 'S') {
+		return stopID[:n-1]
+	}
+	return stopID
+}
+
+// finish fills in the rates. Excess wait is the difference between the
+// average wait of riders arriving at random over the observed headways,
+// sum(h^2)/2sum(h), and over the scheduled ones.
+func (m *Metrics) finish() {
+	if m.Scheduled > 0 {
+		m.OnTimePerformance = float64(m.OnTime) / float64(m.Scheduled)
+	}
+	if m.Headways > 0 {
+		m.WaitAssessment = float64(m.HeadwaysMet) / float64(m.Headways)
+	}
+	if m.actualSum > 0 && m.schedSum > 0 {
+		excess := m.actualSq/(2*m.actualSum) - m.schedSq/(2*m.schedSum)
+		m.ExcessWait = time.Duration(math.Round(excess)) * time.Second
+	}
+	if m.ScheduledStops > 0 {
+		m.TripCompletion = float64(m.CompletedStops) / float64(m.ScheduledStops)
+	}
+}
+
+// scheduledHeadway returns the time between a scheduled train and the one
+// before it, or the scheduled interval t falls in.
+func scheduledHeadway(times []int64, t time.Time) time.Duration {
+	i := sort.Search(len(times), func(i int) bool {
+		return times[i] >= t.Unix()
+	})
+	if i == 0 || i == len(times) {
+		return 0
+	}
+	return time.Duration(times[i]-times[i-1]) * time.Second
+}
@@ -0,0 +1,119 @@
+package report
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jprobinson/gtfs/mta"
+	"github.com/jprobinson/gtfs/static"
+)
+
+func testReport(t *testing.T, by Dimension) Report {
+	t.Helper()
+	feed, err := static.LoadDir("../testdata/subway")
+	if err != nil {
+		t.Fatalf("unable to load test feed: %s", err)
+	}
+	at := func(hour, min int) time.Time {
+		return time.Date(2020, 2, 18, hour, min, 0, 0, feed.Location)
+	}
+	date := at(0, 0)
+	obs := func(tripID string, sched, actual time.Time) mta.Observation {
+		o := mta.Observation{
+			StaticTripID: tripID,
+			RouteID:      "1",
+			StopID:       "101S",
+			Direction:    "S",
+			Departure:    actual,
+		}
+		if !sched.IsZero() {
+			o.ServiceDate = date
+			o.ScheduledDeparture = sched
+			o.Delay = actual.Sub(sched)
+		}
+		return o
+	}
+
+	// route 1 is scheduled to leave 101 at 08:00, 08:10, 08:10 and 08:20
+	observations := []mta.Observation{
+		obs("AFA19GEN-1037-Weekday-00_048000_1..S03R", at(8, 0), at(8, 0)),
+		obs("AFA19GEN-1037-Weekday-00_049000_1..S01R", at(8, 10), at(8, 17)),
+		obs("AFA19GEN-1037-Weekday-00_049000_1..S03R", at(8, 10), at(8, 18)),
+		obs("AFA19GEN-1037-Weekday-00_050000_1..S03R", at(8, 20), at(8, 19)),
+		// unscheduled
+		obs("", time.Time{}, at(8, 30)),
+		// outside the period
+		obs("AFA19GEN-1037-Weekday-00_050000_1..S03R", at(8, 20), at(10, 0)),
+	}
+	return New(feed, observations, at(7, 0), at(9, 0), by)
+}
+
+func TestReport(t *testing.T) {
+	rep := testReport(t, ByRoute|ByDirection)
+	if len(rep.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2: %+v", len(rep.Rows), rep.Rows)
+	}
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"route 1 observations", float64(rep.Rows[0].Observations), 5},
+		{"route 1 scheduled", float64(rep.Rows[0].Scheduled), 4},
+		// 08:17 and 08:18 are more than five minutes late
+		{"route 1 on time", float64(rep.Rows[0].OnTime), 2},
+		{"route 1 on time performance", rep.Rows[0].OnTimePerformance, 0.5},
+		// 17m against 10m scheduled misses, the bunched 1m headways meet
+		// the standard and 08:30 has no scheduled headway to compare with
+		{"route 1 headways", float64(rep.Rows[0].Headways), 3},
+		{"route 1 headways met", float64(rep.Rows[0].HeadwaysMet), 2},
+		{"route 1 wait assessment", rep.Rows[0].WaitAssessment, 2.0 / 3},
+		// (17²+1²+1²)/2(17+1+1) - (10²·3)/2(10·3) minutes, in seconds
+		{"route 1 excess wait", rep.Rows[0].ExcessWait.Seconds(), 159},
+		{"route 1 scheduled stops", float64(rep.Rows[0].ScheduledStops), 11},
+		{"route 1 completed stops", float64(rep.Rows[0].CompletedStops), 4},
+		{"route 1 trip completion", rep.Rows[0].TripCompletion, 4.0 / 11},
+		{"route 2 observations", float64(rep.Rows[1].Observations), 0},
+		{"route 2 on time performance", rep.Rows[1].OnTimePerformance, 0},
+		{"route 2 scheduled stops", float64(rep.Rows[1].ScheduledStops), 4},
+		{"route 2 trip completion", rep.Rows[1].TripCompletion, 0},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > 1e-9 {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if k := rep.Rows[0].Key; k.RouteID != "1" || k.Direction != "S" || k.StationID != "" || k.Hour != -1 {
+		t.Errorf("got row key %+v", k)
+	}
+}
+
+func TestReportByHour(t *testing.T) {
+	rep := testReport(t, ByStation|ByHour)
+	var got []string
+	for _, r := range rep.Rows {
+		got = append(got, r.StationID+"@"+strconv.Itoa(r.Hour))
+	}
+	want := "101@8 103@8 104@8 201@8 204@8"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got rows %v, want %s", got, want)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testReport(t, ByRoute)); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join(csvColumns, ",") + "\n" +
+		"1,,,,5,4,2,0.5000,3,2,0.6667,159,11,4,0.3636\n" +
+		"2,,,,0,0,0,,0,0,,,4,0,0.0000\n"
+	if buf.String() != want {
+		t.Errorf("got CSV\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,71 @@
+package routing
+
+import (
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+// LegKind tells riding legs from walking ones.
+type LegKind int
+
+const (
+	Ride LegKind = iota
+	Walk
+)
+
+func (k LegKind) String() string {
+	if k == Walk {
+		return "walk"
+	}
+	return "ride"
+}
+
+// Leg is one part of an itinerary: riding a single trip or walking between
+// two stops.
+type Leg struct {
+	Kind LegKind
+
+	FromStopID string
+	ToStopID   string
+
+	Departure time.Time
+	Arrival   time.Time
+
+	// Trip and ServiceDate are only set for Ride legs. Trips running without
+	// a match in the schedule only carry their realtime ID and route.
+	Trip        static.Trip
+	ServiceDate time.Time
+
+	// Realtime is true when the times come from live predictions.
+	Realtime bool
+	// Missed is set by Recheck when the leg can no longer be made: the trip
+	// was cancelled, skips the stop, or leaves before the rider gets there.
+	Missed bool
+}
+
+// Itinerary is a journey from one stop to another.
+type Itinerary struct {
+	Legs []Leg
+
+	Departure time.Time
+	Arrival   time.Time
+	// Transfers is the number of times the rider changes trains.
+	Transfers int
+}
+
+// Duration is the time from the start of the first leg to the end of the
+// last.
+func (it Itinerary) Duration() time.Duration {
+	return it.Arrival.Sub(it.Departure)
+}
+
+// Missed reports whether any leg of the itinerary can no longer be made.
+func (it Itinerary) Missed() bool {
+	for _, l := range it.Legs {
+		if l.Missed {
+			return true
+		}
+	}
+	return false
+}
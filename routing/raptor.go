@@ -0,0 +1,560 @@
+// Package routing plans journeys over a static GTFS schedule using RAPTOR
+// (Round-bAsed Public Transit Optimized Router). Each round of the algorithm
+// allows one more trip to be ridden, which yields the Pareto optimal set of
+// itineraries trading arrival time against the number of transfers.
+package routing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+// DefaultMaxTransfers is the number of transfers EarliestArrival allows.
+const DefaultMaxTransfers = 5
+
+var (
+	// ErrUnknownStop is returned when an origin or destination is not in the
+	// feed.
+	ErrUnknownStop = errors.New("unknown stop")
+	// ErrNoRoute is returned when the destination cannot be reached.
+	ErrNoRoute = errors.New("no route found")
+)
+
+const never = math.MaxInt64
+
+// Planner plans journeys over a static feed. It is safe for concurrent use.
+type Planner struct {
+	feed *static.Feed
+
+	stopIDs []string
+	stopIdx map[string]int
+	// parent station ID => platform indexes
+	children map[string][]int
+	// stop index => walks to other stops
+	footpaths [][]footpath
+
+	mu sync.Mutex
+	// service date => timetable
+	tables map[string]*timetable
+}
+
+type footpath struct {
+	to  int
+	dur int64
+}
+
+// NewPlanner will index the stops and transfers of a feed for planning.
+// Transfers from transfers.txt are expanded to every platform of the
+// stations they connect, using min_transfer_time as the walk.
+func NewPlanner(feed *static.Feed) *Planner {
+	p := &Planner{
+		feed:     feed,
+		stopIdx:  map[string]int{},
+		children: map[string][]int{},
+		tables:   map[string]*timetable{},
+	}
+	for id := range feed.Stops {
+		p.stopIDs = append(p.stopIDs, id)
+	}
+	sort.Strings(p.stopIDs)
+	for idx, id := range p.stopIDs {
+		p.stopIdx[id] = idx
+		if parent := feed.Stops[id].ParentStation; parent != "" {
+			p.children[parent] = append(p.children[parent], idx)
+		}
+	}
+
+	walks := make([]map[int]int64, len(p.stopIDs))
+	for _, xfer := range feed.Transfers {
+		if xfer.Type == static.TransferNotPossible {
+			continue
+		}
+		dur := int64(xfer.MinTransferTime / time.Second)
+		for _, from := range p.platforms(xfer.FromStopID) {
+			for _, to := range p.platforms(xfer.ToStopID) {
+				if from == to {
+					continue
+				}
+				if walks[from] == nil {
+					walks[from] = map[int]int64{}
+				}
+				if cur, ok := walks[from][to]; !ok || dur < cur {
+					walks[from][to] = dur
+				}
+			}
+		}
+	}
+	p.footpaths = make([][]footpath, len(p.stopIDs))
+	for from, tos := range walks {
+		for to, dur := range tos {
+			p.footpaths[from] = append(p.footpaths[from], footpath{to: to, dur: dur})
+		}
+		sort.Slice(p.footpaths[from], func(i, j int) bool {
+			return p.footpaths[from][i].to < p.footpaths[from][j].to
+		})
+	}
+	return p
+}
+
+// platforms resolves a stop ID to the platforms trains stop at: a parent
+// station's children or the stop itself.
+func (p *Planner) platforms(stopID string) []int {
+	if kids, ok := p.children[stopID]; ok {
+		return kids
+	}
+	if idx, ok := p.stopIdx[stopID]; ok {
+		return []int{idx}
+	}
+	return nil
+}
+
+// EarliestArrival will return the itinerary arriving soonest at the
+// destination when leaving the origin at depart. Stops may be platforms or
+// parent stations.
+func (p *Planner) EarliestArrival(from, to string, depart time.Time) (Itinerary, error) {
+	its, err := p.Plan(from, to, depart, DefaultMaxTransfers)
+	if err != nil {
+		return Itinerary{}, err
+	}
+	return its[len(its)-1], nil
+}
+
+// Plan will return the Pareto optimal itineraries between two stops when
+// leaving at depart: each itinerary arrives earlier than the ones before it
+// but needs more transfers. At most maxTransfers transfers are considered.
+func (p *Planner) Plan(from, to string, depart time.Time, maxTransfers int) ([]Itinerary, error) {
+	return p.plan(p.timetable(p.feed.ServiceDate(depart)), from, to, depart, maxTransfers)
+}
+
+// timetable is the schedule of every trip running around a service date,
+// grouped into patterns of trips sharing a route and stop sequence.
+type timetable struct {
+	patterns []pattern
+	// stop index => where the stop appears in patterns
+	stopPatterns [][]patternStop
+}
+
+type patternStop struct {
+	pattern int
+	pos     int
+}
+
+type pattern struct {
+	routeID string
+	stops   []int
+	// ordered by departure from the first stop
+	trips []*tripTimes
+	// overtaking is set when realtime delays let a trip pass an earlier one,
+	// so trips are no longer ordered at every stop.
+	overtaking bool
+}
+
+// tripTimes are a trip's absolute arrival and departure times in Unix
+// seconds, indexed by position in its pattern.
+type tripTimes struct {
+	trip        static.Trip
+	serviceDate time.Time
+
+	arr       []int64
+	dep       []int64
+	noPickup  []bool
+	noDropOff []bool
+
+	// live is set when the times come from realtime predictions.
+	live bool
+}
+
+func (p *Planner) timetable(day time.Time) *timetable {
+	key := day.Format(static.DateFormat)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if tt, ok := p.tables[key]; ok {
+		return tt
+	}
+	// timetables are large, only hang on to the last few days
+	if len(p.tables) >= 3 {
+		p.tables = map[string]*timetable{}
+	}
+	tt := p.buildTimetable(day)
+	p.tables[key] = tt
+	return tt
+}
+
+// buildTimetable includes the day before, for service after midnight, and
+// the day after, for journeys that run past midnight.
+func (p *Planner) buildTimetable(day time.Time) *timetable {
+	tt := &timetable{stopPatterns: make([][]patternStop, len(p.stopIDs))}
+	byKey := map[string]int{}
+
+	for _, d := range []time.Time{day.AddDate(0, 0, -1), day, day.AddDate(0, 0, 1)} {
+		base := static.Time(0).On(d).Unix()
+		for _, trip := range p.feed.TripsOn(d, "") {
+			tr, stops, ok := p.tripTimes(trip, d, base)
+			if !ok {
+				continue
+			}
+
+			var key strings.Builder
+			key.WriteString(trip.RouteID)
+			for _, s := range stops {
+				key.WriteString("|" + strconv.Itoa(s))
+			}
+			pi, ok := byKey[key.String()]
+			if !ok {
+				pi = len(tt.patterns)
+				byKey[key.String()] = pi
+				tt.patterns = append(tt.patterns, pattern{routeID: trip.RouteID, stops: stops})
+				for pos, s := range stops {
+					tt.stopPatterns[s] = append(tt.stopPatterns[s], patternStop{pattern: pi, pos: pos})
+				}
+			}
+			tt.patterns[pi].trips = append(tt.patterns[pi].trips, tr)
+		}
+	}
+
+	for _, pat := range tt.patterns {
+		trips := pat.trips
+		sort.SliceStable(trips, func(i, j int) bool {
+			return trips[i].dep[0] < trips[j].dep[0]
+		})
+	}
+	return tt
+}
+
+func (p *Planner) tripTimes(trip static.Trip, day time.Time, base int64) (*tripTimes, []int, bool) {
+	sts := p.feed.StopTimes[trip.ID]
+	if len(sts) < 2 {
+		return nil, nil, false
+	}
+	tr := &tripTimes{
+		trip:        trip,
+		serviceDate: day,
+		arr:         make([]int64, len(sts)),
+		dep:         make([]int64, len(sts)),
+		noPickup:    make([]bool, len(sts)),
+		noDropOff:   make([]bool, len(sts)),
+	}
+	stops := make([]int, len(sts))
+	for i, st := range sts {
+		idx, ok := p.stopIdx[st.StopID]
+		if !ok {
+			return nil, nil, false
+		}
+		arr, dep := st.ArrivalTime, st.DepartureTime
+		if arr == static.NoTime {
+			arr = dep
+		}
+		if dep == static.NoTime {
+			dep = arr
+		}
+		// untimed stops are not supported
+		if arr == static.NoTime {
+			return nil, nil, false
+		}
+		stops[i] = idx
+		tr.arr[i] = base + int64(time.Duration(arr)/time.Second)
+		tr.dep[i] = base + int64(time.Duration(dep)/time.Second)
+		tr.noPickup[i] = st.PickupType == 1
+		tr.noDropOff[i] = st.DropOffType == 1
+	}
+	return tr, stops, true
+}
+
+// earliestTrip returns the index of the first trip in a pattern that can be
+// boarded at pos no earlier than t, or -1.
+func (pat *pattern) earliestTrip(pos int, t int64) int {
+	if pat.overtaking {
+		best := -1
+		for i, tr := range pat.trips {
+			if tr.dep[pos] >= t && !tr.noPickup[pos] && (best < 0 || tr.dep[pos] < pat.trips[best].dep[pos]) {
+				best = i
+			}
+		}
+		return best
+	}
+
+	i := sort.Search(len(pat.trips), func(i int) bool {
+		return pat.trips[i].dep[pos] >= t
+	})
+	for ; i < len(pat.trips); i++ {
+		if !pat.trips[i].noPickup[pos] {
+			return i
+		}
+	}
+	return -1
+}
+
+type labelKind int
+
+const (
+	labelNone labelKind = iota
+	labelSource
+	labelRide
+	labelWalk
+)
+
+// label records how a stop was reached in a round.
+type label struct {
+	kind labelKind
+
+	// ride
+	trip      *tripTimes
+	boardStop int
+	boardPos  int
+	alightPos int
+
+	// walk
+	from int
+	dur  int64
+}
+
+func (p *Planner) plan(tt *timetable, from, to string, depart time.Time, maxTransfers int) ([]Itinerary, error) {
+	sources, targets := p.platforms(from), p.platforms(to)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStop, from)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStop, to)
+	}
+	if maxTransfers < 0 {
+		maxTransfers = 0
+	}
+	isTarget := make([]bool, len(p.stopIDs))
+	for _, t := range targets {
+		isTarget[t] = true
+	}
+
+	rounds := maxTransfers + 1
+	tau := make([][]int64, rounds+1)
+	labels := make([][]label, rounds+1)
+	best := make([]int64, len(p.stopIDs))
+	for i := range best {
+		best[i] = never
+	}
+	targetBest := func() int64 {
+		min := int64(never)
+		for _, t := range targets {
+			if best[t] < min {
+				min = best[t]
+			}
+		}
+		return min
+	}
+
+	tau[0] = make([]int64, len(p.stopIDs))
+	labels[0] = make([]label, len(p.stopIDs))
+	copy(tau[0], best)
+	var marked []int
+	for _, s := range sources {
+		tau[0][s] = depart.Unix()
+		best[s] = depart.Unix()
+		labels[0][s] = label{kind: labelSource}
+		marked = append(marked, s)
+	}
+	marked = p.walk(0, marked, tau, labels, best, targetBest)
+
+	for k := 1; k <= rounds && len(marked) > 0; k++ {
+		tau[k] = make([]int64, len(p.stopIDs))
+		copy(tau[k], tau[k-1])
+		labels[k] = make([]label, len(p.stopIDs))
+
+		// the earliest marked position of every pattern serving a marked stop
+		queue := map[int]int{}
+		for _, s := range marked {
+			for _, ps := range tt.stopPatterns[s] {
+				if cur, ok := queue[ps.pattern]; !ok || ps.pos < cur {
+					queue[ps.pattern] = ps.pos
+				}
+			}
+		}
+		pis := make([]int, 0, len(queue))
+		for pi := range queue {
+			pis = append(pis, pi)
+		}
+		sort.Ints(pis)
+
+		marked = nil
+		seen := map[int]bool{}
+		for _, pi := range pis {
+			pat := &tt.patterns[pi]
+			var (
+				cur       *tripTimes
+				boardStop int
+				boardPos  int
+			)
+			for pos := queue[pi]; pos < len(pat.stops); pos++ {
+				s := pat.stops[pos]
+				if cur != nil && !cur.noDropOff[pos] {
+					arr := cur.arr[pos]
+					if arr < best[s] && arr < targetBest() {
+						tau[k][s] = arr
+						best[s] = arr
+						labels[k][s] = label{
+							kind:      labelRide,
+							trip:      cur,
+							boardStop: boardStop,
+							boardPos:  boardPos,
+							alightPos: pos,
+						}
+						if !seen[s] {
+							seen[s] = true
+							marked = append(marked, s)
+						}
+					}
+				}
+
+				// hop on an earlier trip if the stop was reached in time
+				prev := tau[k-1][s]
+				if prev == never || (cur != nil && prev > cur.dep[pos]) {
+					continue
+				}
+				if ti := pat.earliestTrip(pos, prev); ti >= 0 {
+					if cur == nil || pat.trips[ti].dep[pos] < cur.dep[pos] {
+						cur = pat.trips[ti]
+						boardStop = s
+						boardPos = pos
+					}
+				}
+			}
+		}
+		sort.Ints(marked)
+		marked = p.walk(k, marked, tau, labels, best, targetBest)
+	}
+
+	var (
+		out     []Itinerary
+		arrived = int64(never)
+	)
+	for k := 0; k <= rounds && labels[k] != nil; k++ {
+		target, arr := -1, int64(never)
+		for _, t := range targets {
+			if labels[k][t].kind != labelNone && tau[k][t] < arr {
+				target, arr = t, tau[k][t]
+			}
+		}
+		if target < 0 || arr >= arrived || labels[k][target].kind == labelSource {
+			continue
+		}
+		arrived = arr
+		out = append(out, p.itinerary(labels, tau, k, target))
+	}
+	if len(out) == 0 {
+		return nil, ErrNoRoute
+	}
+	return out, nil
+}
+
+// walk relaxes the footpaths out of the stops marked in round k and returns
+// the marked stops including those reached on foot. Walks never chain.
+func (p *Planner) walk(k int, marked []int, tau [][]int64, labels [][]label, best []int64, targetBest func() int64) []int {
+	pending := map[int]label{}
+	times := map[int]int64{}
+	for _, s := range marked {
+		for _, fp := range p.footpaths[s] {
+			t := tau[k][s] + fp.dur
+			if cur, ok := times[fp.to]; ok && cur <= t {
+				continue
+			}
+			times[fp.to] = t
+			pending[fp.to] = label{kind: labelWalk, from: s, dur: fp.dur}
+		}
+	}
+
+	seen := map[int]bool{}
+	for _, s := range marked {
+		seen[s] = true
+	}
+	tos := make([]int, 0, len(pending))
+	for to := range pending {
+		tos = append(tos, to)
+	}
+	sort.Ints(tos)
+	for _, to := range tos {
+		t := times[to]
+		if t >= best[to] || t >= targetBest() {
+			continue
+		}
+		tau[k][to] = t
+		best[to] = t
+		labels[k][to] = pending[to]
+		if !seen[to] {
+			seen[to] = true
+			marked = append(marked, to)
+		}
+	}
+	return marked
+}
+
+// itinerary walks the labels back from the target reached in round k.
+func (p *Planner) itinerary(labels [][]label, tau [][]int64, k, target int) Itinerary {
+	var (
+		legs  []Leg
+		rides int
+		s     = target
+	)
+	for k >= 0 {
+		l := labels[k][s]
+		if l.kind == labelNone {
+			k--
+			continue
+		}
+		if l.kind == labelSource {
+			break
+		}
+
+		if l.kind == labelWalk {
+			legs = append(legs, Leg{
+				Kind:       Walk,
+				FromStopID: p.stopIDs[l.from],
+				ToStopID:   p.stopIDs[s],
+				Departure:  p.unix(tau[k][s] - l.dur),
+				Arrival:    p.unix(tau[k][s]),
+			})
+			s = l.from
+			continue
+		}
+
+		legs = append(legs, Leg{
+			Kind:        Ride,
+			FromStopID:  p.stopIDs[l.boardStop],
+			ToStopID:    p.stopIDs[s],
+			Departure:   p.unix(l.trip.dep[l.boardPos]),
+			Arrival:     p.unix(l.trip.arr[l.alightPos]),
+			Trip:        l.trip.trip,
+			ServiceDate: l.trip.serviceDate,
+			Realtime:    l.trip.live,
+		})
+		rides++
+		s = l.boardStop
+		k--
+	}
+
+	for i, j := 0, len(legs)-1; i < j; i, j = i+1, j-1 {
+		legs[i], legs[j] = legs[j], legs[i]
+	}
+	it := Itinerary{Legs: legs}
+	if len(legs) > 0 {
+		it.Departure = legs[0].Departure
+		it.Arrival = legs[len(legs)-1].Arrival
+	}
+	if rides > 1 {
+		it.Transfers = rides - 1
+	}
+	return it
+}
+
+func (p *Planner) unix(t int64) time.Time {
+	loc := p.feed.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Unix(t, 0).In(loc)
+}
@@ -0,0 +1,128 @@
+package routing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jprobinson/gtfs/static"
+)
+
+func testPlanner(t *testing.T) *Planner {
+	t.Helper()
+	feed, err := static.LoadDir("../testdata/subway")
+	if err != nil {
+		t.Fatalf("unable to load test feed: %s", err)
+	}
+	return NewPlanner(feed)
+}
+
+type testLeg struct {
+	kind     LegKind
+	from, to string
+	dep, arr string
+	tripID   string
+}
+
+func TestPlan(t *testing.T) {
+	p := testPlanner(t)
+	// at takes a time on 2020-02-18 or a full date and time
+	at := func(clock string) time.Time {
+		if len(clock) == len("15:04") {
+			clock = "2020-02-18 " + clock
+		}
+		tm, err := time.ParseInLocation("2006-01-02 15:04", clock, p.feed.Location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	tests := []struct {
+		name         string
+		from, to     string
+		depart       string
+		maxTransfers int
+
+		wantErr error
+		// want are the legs of the last itinerary, the earliest to arrive
+		want          []testLeg
+		wantTransfers int
+	}{
+		{
+			name: "one ride", from: "101", to: "104", depart: "07:55", maxTransfers: 1,
+			want: []testLeg{
+				{Ride, "101S", "104S", "08:00", "08:10", "AFA19GEN-1037-Weekday-00_048000_1..S03R"},
+			},
+		},
+		{
+			// the 08:12 train leaves before the 180 second transfer is done
+			name: "one transfer", from: "101", to: "204", depart: "08:00", maxTransfers: 1,
+			want: []testLeg{
+				{Ride, "101S", "104S", "08:00", "08:10", "AFA19GEN-1037-Weekday-00_048000_1..S03R"},
+				{Walk, "104S", "201S", "08:10", "08:13", ""},
+				{Ride, "201S", "204S", "08:15", "08:20", "AFA19GEN-2047-Weekday-00_049500_2..S01R"},
+			},
+			wantTransfers: 1,
+		},
+		{
+			name: "platform to platform", from: "103S", to: "204S", depart: "08:00", maxTransfers: 1,
+			want: []testLeg{
+				{Ride, "103S", "104S", "08:05", "08:10", "AFA19GEN-1037-Weekday-00_048000_1..S03R"},
+				{Walk, "104S", "201S", "08:10", "08:13", ""},
+				{Ride, "201S", "204S", "08:15", "08:20", "AFA19GEN-2047-Weekday-00_049500_2..S01R"},
+			},
+			wantTransfers: 1,
+		},
+		{
+			// the last connection of the day is missed
+			name: "next day", from: "101", to: "204", depart: "08:01", maxTransfers: 1,
+			want: []testLeg{
+				{Ride, "101S", "104S", "08:10", "08:20", "AFA19GEN-1037-Weekday-00_049000_1..S03R"},
+				{Walk, "104S", "201S", "08:20", "08:23", ""},
+				{Ride, "201S", "204S", "2020-02-19 08:12", "2020-02-19 08:17", "AFA19GEN-2047-Weekday-00_049200_2..S01R"},
+			},
+			wantTransfers: 1,
+		},
+		{
+			name: "transfers not allowed", from: "101", to: "204", depart: "08:00", maxTransfers: 0,
+			wantErr: ErrNoRoute,
+		},
+		{
+			name: "unknown stop", from: "101", to: "999", depart: "08:00", maxTransfers: 1,
+			wantErr: ErrUnknownStop,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its, err := p.Plan(tt.from, tt.to, at(tt.depart), tt.maxTransfers)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			it := its[len(its)-1]
+			if it.Transfers != tt.wantTransfers {
+				t.Errorf("got %d transfers, want %d", it.Transfers, tt.wantTransfers)
+			}
+			if len(it.Legs) != len(tt.want) {
+				t.Fatalf("got %d legs, want %d: %+v", len(it.Legs), len(tt.want), it.Legs)
+			}
+			for i, want := range tt.want {
+				leg := it.Legs[i]
+				if leg.Kind != want.kind || leg.FromStopID != want.from || leg.ToStopID != want.to ||
+					!leg.Departure.Equal(at(want.dep)) || !leg.Arrival.Equal(at(want.arr)) || leg.Trip.ID != want.tripID {
+					t.Errorf("leg %d = %s %s %s-%s %s %s %s, want %+v", i, leg.Kind, leg.FromStopID,
+						leg.Departure.Format("15:04"), leg.ToStopID, leg.Arrival.Format("15:04"), leg.Trip.ID, want.kind, want)
+				}
+			}
+			if !it.Departure.Equal(at(tt.want[0].dep)) || !it.Arrival.Equal(at(tt.want[len(tt.want)-1].arr)) {
+				t.Errorf("itinerary runs %s to %s", it.Departure, it.Arrival)
+			}
+		})
+	}
+}
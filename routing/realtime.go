@@ -0,0 +1,372 @@
+package routing
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jprobinson/gtfs/mta"
+	"github.com/jprobinson/gtfs/static"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// Realtime is the live state of the trips in a snapshot of realtime feeds,
+// matched against the static schedule.
+type Realtime struct {
+	now time.Time
+
+	// trip key => live trip
+	live map[string]*liveTrip
+	// trip key => cancelled
+	cancelled map[string]bool
+	// trips running without a match in the schedule
+	added []*liveTrip
+}
+
+type liveTrip struct {
+	trip        static.Trip
+	serviceDate time.Time
+
+	updates []*transit_realtime.TripUpdate_StopTimeUpdate
+	// stop ID => update
+	byStop map[string]*transit_realtime.TripUpdate_StopTimeUpdate
+}
+
+// NewRealtime will match every trip update in the snapshot to the static
+// schedule as of now. Trips marked as canceled and scheduled trips missing
+// from a route's trip replacement period (see mta.CancelledTrips) are
+// treated as cancelled.
+func NewRealtime(snap *mta.Snapshot, m *mta.TripMatcher, now time.Time) *Realtime {
+	rt := &Realtime{
+		now:       now,
+		live:      map[string]*liveTrip{},
+		cancelled: map[string]bool{},
+	}
+	for _, feed := range snap.Feeds {
+		for _, period := range mta.CancelledTrips(feed, m, now) {
+			for _, ct := range period.Cancelled {
+				rt.cancelled[tripKey(ct.Trip.ID, ct.ServiceDate)] = true
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(snap.Trips))
+	for id := range snap.Trips {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		tu := snap.Trips[id]
+		td := tu.GetTrip()
+		lt := &liveTrip{
+			updates: tu.GetStopTimeUpdate(),
+			byStop:  map[string]*transit_realtime.TripUpdate_StopTimeUpdate{},
+		}
+		for _, upd := range lt.updates {
+			lt.byStop[upd.GetStopId()] = upd
+		}
+
+		trip, date, err := m.Match(td, now)
+		if err != nil {
+			if td.GetScheduleRelationship() == transit_realtime.TripDescriptor_CANCELED || len(lt.updates) == 0 {
+				continue
+			}
+			lt.trip = static.Trip{ID: td.GetTripId(), RouteID: td.GetRouteId()}
+			rt.added = append(rt.added, lt)
+			continue
+		}
+
+		key := tripKey(trip.ID, date)
+		if td.GetScheduleRelationship() == transit_realtime.TripDescriptor_CANCELED {
+			rt.cancelled[key] = true
+			continue
+		}
+		if len(lt.updates) == 0 {
+			continue
+		}
+		lt.trip, lt.serviceDate = trip, date
+		rt.live[key] = lt
+	}
+	return rt
+}
+
+func tripKey(tripID string, serviceDate time.Time) string {
+	return tripID + "|" + serviceDate.Format(static.DateFormat)
+}
+
+// PlanRealtime behaves like Plan but uses the predicted times of trains in
+// the realtime data, skips stops trains are not making and ignores
+// cancelled trips. Trips without realtime data keep their scheduled times.
+func (p *Planner) PlanRealtime(rt *Realtime, from, to string, depart time.Time, maxTransfers int) ([]Itinerary, error) {
+	tt := p.timetable(p.feed.ServiceDate(depart))
+	return p.plan(p.overlay(tt, rt), from, to, depart, maxTransfers)
+}
+
+// overlay returns a copy of a timetable with the realtime data applied.
+func (p *Planner) overlay(tt *timetable, rt *Realtime) *timetable {
+	out := &timetable{
+		patterns:     make([]pattern, len(tt.patterns), len(tt.patterns)+len(rt.added)),
+		stopPatterns: make([][]patternStop, len(tt.stopPatterns)),
+	}
+	copy(out.stopPatterns, tt.stopPatterns)
+
+	for pi, pat := range tt.patterns {
+		trips := make([]*tripTimes, 0, len(pat.trips))
+		changed := false
+		for _, tr := range pat.trips {
+			key := tripKey(tr.trip.ID, tr.serviceDate)
+			if rt.cancelled[key] {
+				changed = true
+				continue
+			}
+			if lt, ok := rt.live[key]; ok {
+				tr, _ = p.applyLive(tr, pat.stops, lt, true)
+				changed = true
+			}
+			trips = append(trips, tr)
+		}
+		pat.trips = trips
+		if changed {
+			sortTrips(&pat)
+		}
+		out.patterns[pi] = pat
+	}
+
+	byKey := map[string]int{}
+	for _, lt := range rt.added {
+		tr, stops := p.addedTrip(lt)
+		if tr == nil {
+			continue
+		}
+		var key strings.Builder
+		key.WriteString(lt.trip.RouteID)
+		for _, s := range stops {
+			key.WriteString("|" + strconv.Itoa(s))
+		}
+		pi, ok := byKey[key.String()]
+		if !ok {
+			pi = len(out.patterns)
+			byKey[key.String()] = pi
+			out.patterns = append(out.patterns, pattern{routeID: lt.trip.RouteID, stops: stops})
+			for pos, s := range stops {
+				// never append to the shared slice of the static timetable
+				ps := out.stopPatterns[s]
+				out.stopPatterns[s] = append(ps[:len(ps):len(ps)], patternStop{pattern: pi, pos: pos})
+			}
+		}
+		out.patterns[pi].trips = append(out.patterns[pi].trips, tr)
+	}
+	for pi := len(tt.patterns); pi < len(out.patterns); pi++ {
+		sortTrips(&out.patterns[pi])
+	}
+	return out
+}
+
+// sortTrips orders the trips of a pattern by first departure and notes
+// whether any trip overtakes another along the way.
+func sortTrips(pat *pattern) {
+	trips := pat.trips
+	sort.SliceStable(trips, func(i, j int) bool {
+		return trips[i].dep[0] < trips[j].dep[0]
+	})
+	pat.overtaking = false
+	for i := 1; i < len(trips) && !pat.overtaking; i++ {
+		for pos := range pat.stops {
+			if trips[i].dep[pos] < trips[i-1].dep[pos] {
+				pat.overtaking = true
+				break
+			}
+		}
+	}
+}
+
+// applyLive returns a copy of a scheduled trip with its realtime updates
+// applied, along with the position of the first stop the feed still lists.
+// Stops before that one have been passed and, when markPassed is set, can
+// no longer be used. Delays carry forward to stops without an update.
+func (p *Planner) applyLive(tr *tripTimes, stops []int, lt *liveTrip, markPassed bool) (*tripTimes, int) {
+	nt := &tripTimes{
+		trip:        tr.trip,
+		serviceDate: tr.serviceDate,
+		arr:         append([]int64(nil), tr.arr...),
+		dep:         append([]int64(nil), tr.dep...),
+		noPickup:    append([]bool(nil), tr.noPickup...),
+		noDropOff:   append([]bool(nil), tr.noDropOff...),
+		live:        true,
+	}
+
+	first := -1
+	var delay, last int64
+	for pos, s := range stops {
+		upd, ok := lt.byStop[p.stopIDs[s]]
+		if !ok && first < 0 {
+			if markPassed {
+				nt.noPickup[pos], nt.noDropOff[pos] = true, true
+			}
+			continue
+		}
+		if first < 0 {
+			first = pos
+		}
+
+		switch {
+		case !ok || upd.GetScheduleRelationship() == transit_realtime.TripUpdate_StopTimeUpdate_NO_DATA:
+			nt.arr[pos] += delay
+			nt.dep[pos] += delay
+		case upd.GetScheduleRelationship() == transit_realtime.TripUpdate_StopTimeUpdate_SKIPPED:
+			nt.noPickup[pos], nt.noDropOff[pos] = true, true
+			continue
+		default:
+			dwell := tr.dep[pos] - tr.arr[pos]
+			arr, arrOK := eventTime(upd.GetArrival(), tr.arr[pos])
+			dep, depOK := eventTime(upd.GetDeparture(), tr.dep[pos])
+			switch {
+			case arrOK && depOK:
+			case arrOK:
+				dep = arr + dwell
+			case depOK:
+				arr = dep - dwell
+			default:
+				arr, dep = tr.arr[pos]+delay, tr.dep[pos]+delay
+			}
+			nt.arr[pos], nt.dep[pos] = arr, dep
+			delay = dep - tr.dep[pos]
+		}
+
+		// keep the train moving forward in time
+		if nt.arr[pos] < last {
+			nt.arr[pos] = last
+		}
+		if nt.dep[pos] < nt.arr[pos] {
+			nt.dep[pos] = nt.arr[pos]
+		}
+		last = nt.dep[pos]
+	}
+	return nt, first
+}
+
+// eventTime returns the predicted time of a stop time event, falling back to
+// the scheduled time plus its delay.
+func eventTime(ev *transit_realtime.TripUpdate_StopTimeEvent, sched int64) (int64, bool) {
+	if ev.GetTime() != 0 {
+		return ev.GetTime(), true
+	}
+	if ev != nil && ev.Delay != nil {
+		return sched + int64(ev.GetDelay()), true
+	}
+	return 0, false
+}
+
+// addedTrip builds the times of a trip missing from the schedule from its
+// predictions alone.
+func (p *Planner) addedTrip(lt *liveTrip) (*tripTimes, []int) {
+	tr := &tripTimes{trip: lt.trip, live: true}
+	var stops []int
+	var last int64
+	for _, upd := range lt.updates {
+		idx, ok := p.stopIdx[upd.GetStopId()]
+		if !ok || upd.GetScheduleRelationship() == transit_realtime.TripUpdate_StopTimeUpdate_SKIPPED {
+			continue
+		}
+		arr, dep := upd.GetArrival().GetTime(), upd.GetDeparture().GetTime()
+		if arr == 0 {
+			arr = dep
+		}
+		if dep == 0 {
+			dep = arr
+		}
+		if arr == 0 || arr < last {
+			continue
+		}
+		if dep < arr {
+			dep = arr
+		}
+		last = dep
+		stops = append(stops, idx)
+		tr.arr = append(tr.arr, arr)
+		tr.dep = append(tr.dep, dep)
+		tr.noPickup = append(tr.noPickup, false)
+		tr.noDropOff = append(tr.noDropOff, false)
+	}
+	if len(stops) < 2 {
+		return nil, nil
+	}
+	return tr, stops
+}
+
+// Recheck will update the ride legs of an itinerary with realtime
+// predictions and flag any leg that can no longer be made, such as a
+// connection missed because the previous train is running late. Walks are
+// shifted to follow the legs before them.
+func (p *Planner) Recheck(it Itinerary, rt *Realtime) Itinerary {
+	legs := make([]Leg, len(it.Legs))
+	copy(legs, it.Legs)
+
+	ready := it.Departure
+	for i := range legs {
+		leg := &legs[i]
+		if leg.Kind == Walk {
+			dur := leg.Arrival.Sub(leg.Departure)
+			leg.Departure = ready
+			leg.Arrival = ready.Add(dur)
+			ready = leg.Arrival
+			continue
+		}
+
+		// riders are assumed to be waiting for the first train
+		if p.recheckRide(leg, rt) || (i > 0 && leg.Departure.Before(ready)) {
+			leg.Missed = true
+		}
+		ready = leg.Arrival
+	}
+
+	it.Legs = legs
+	if len(legs) > 0 {
+		it.Departure = legs[0].Departure
+		it.Arrival = legs[len(legs)-1].Arrival
+	}
+	return it
+}
+
+// recheckRide applies realtime data to a ride leg and reports whether the
+// train no longer serves it.
+func (p *Planner) recheckRide(leg *Leg, rt *Realtime) bool {
+	key := tripKey(leg.Trip.ID, leg.ServiceDate)
+	if rt.cancelled[key] {
+		return true
+	}
+	lt, ok := rt.live[key]
+	if !ok {
+		return false
+	}
+
+	tr, stops, ok := p.tripTimes(leg.Trip, leg.ServiceDate, static.Time(0).On(leg.ServiceDate).Unix())
+	if !ok {
+		return false
+	}
+	tr, first := p.applyLive(tr, stops, lt, false)
+
+	board, alight := -1, -1
+	for pos, s := range stops {
+		if board < 0 && p.stopIDs[s] == leg.FromStopID {
+			board = pos
+		} else if board >= 0 && p.stopIDs[s] == leg.ToStopID {
+			alight = pos
+			break
+		}
+	}
+	if board < 0 || alight < 0 {
+		return true
+	}
+
+	// a train past the boarding stop is only fine if the rider is on it
+	boarded := !leg.Departure.After(rt.now)
+	leg.Departure = p.unix(tr.dep[board])
+	leg.Arrival = p.unix(tr.arr[alight])
+	leg.Realtime = true
+	if first < 0 || board < first {
+		return !boarded
+	}
+	return tr.noPickup[board] || tr.noDropOff[alight]
+}
@@ -0,0 +1,332 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/jprobinson/gtfs/mta"
+	"github.com/jprobinson/gtfs/transit_realtime"
+)
+
+// testClock returns a func parsing times like the one in TestPlan.
+func testClock(t *testing.T, p *Planner) func(string) time.Time {
+	return func(clock string) time.Time {
+		t.Helper()
+		if len(clock) == len("15:04") {
+			clock = "2020-02-18 " + clock
+		}
+		tm, err := time.ParseInLocation("2006-01-02 15:04", clock, p.feed.Location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+}
+
+// testUpdate is a stop time update arriving and departing at the given
+// time, or skipping the stop if the time is empty.
+func testUpdate(at func(string) time.Time, stopID, clock string) *transit_realtime.TripUpdate_StopTimeUpdate {
+	upd := &transit_realtime.TripUpdate_StopTimeUpdate{StopId: proto.String(stopID)}
+	if clock == "" {
+		upd.ScheduleRelationship = transit_realtime.TripUpdate_StopTimeUpdate_SKIPPED.Enum()
+		return upd
+	}
+	ts := at(clock).Unix()
+	upd.Arrival = &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(ts)}
+	upd.Departure = &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(ts)}
+	return upd
+}
+
+func testTripUpdate(tripID string, rel transit_realtime.TripDescriptor_ScheduleRelationship, upds ...*transit_realtime.TripUpdate_StopTimeUpdate) *transit_realtime.TripUpdate {
+	return &transit_realtime.TripUpdate{
+		Trip: &transit_realtime.TripDescriptor{
+			TripId:               proto.String(tripID),
+			RouteId:              proto.String("1"),
+			StartDate:            proto.String("20200218"),
+			ScheduleRelationship: rel.Enum(),
+		},
+		StopTimeUpdate: upds,
+	}
+}
+
+// testRealtime matches trip updates as of now. When replaceUntil is set the
+// feed claims every 1 train until then, cancelling scheduled trips it
+// leaves out.
+func testRealtime(t *testing.T, p *Planner, now, replaceUntil time.Time, tus ...*transit_realtime.TripUpdate) *Realtime {
+	t.Helper()
+	feed := &transit_realtime.FeedMessage{
+		Header: &transit_realtime.FeedHeader{
+			GtfsRealtimeVersion: proto.String("1.0"),
+			Timestamp:           proto.Uint64(uint64(now.Unix())),
+		},
+	}
+	if !replaceUntil.IsZero() {
+		err := proto.SetExtension(feed.Header, transit_realtime.E_NyctFeedHeader, &transit_realtime.NyctFeedHeader{
+			NyctSubwayVersion: proto.String("1.0"),
+			TripReplacementPeriod: []*transit_realtime.TripReplacementPeriod{{
+				RouteId: proto.String("1"),
+				ReplacementPeriod: &transit_realtime.TimeRange{
+					End: proto.Uint64(uint64(replaceUntil.Unix())),
+				},
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tu := range tus {
+		feed.Entity = append(feed.Entity, &transit_realtime.FeedEntity{
+			Id:         tu.Trip.TripId,
+			TripUpdate: tu,
+		})
+	}
+	snap := mta.NewSnapshot(map[mta.FeedType]*transit_realtime.FeedMessage{mta.NumberedFeed: feed})
+	return NewRealtime(snap, mta.NewTripMatcher(p.feed), now)
+}
+
+func TestPlanRealtime(t *testing.T) {
+	p := testPlanner(t)
+	at := testClock(t, p)
+	const (
+		scheduled = transit_realtime.TripDescriptor_SCHEDULED
+		canceled  = transit_realtime.TripDescriptor_CANCELED
+		added     = transit_realtime.TripDescriptor_ADDED
+
+		first  = "AFA19GEN-1037-Weekday-00_048000_1..S03R"
+		second = "AFA19GEN-1037-Weekday-00_049000_1..S03R"
+	)
+
+	tests := []struct {
+		name         string
+		from, to     string
+		depart       string
+		trips        []*transit_realtime.TripUpdate
+		replaceUntil string
+
+		want     testLeg
+		wantLive bool
+	}{
+		{
+			name: "no realtime data", from: "101", to: "104", depart: "07:55",
+			want: testLeg{Ride, "101S", "104S", "08:00", "08:10", first},
+		},
+		{
+			// the delay at 101 carries on to 104
+			name: "delayed", from: "101", to: "104", depart: "07:55",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled, testUpdate(at, "101S", "08:03")),
+			},
+			want:     testLeg{Ride, "101S", "104S", "08:03", "08:13", first},
+			wantLive: true,
+		},
+		{
+			name: "skipped stop", from: "103", to: "104", depart: "08:00",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled,
+					testUpdate(at, "101S", "08:00"),
+					testUpdate(at, "103S", ""),
+					testUpdate(at, "104S", "08:10")),
+			},
+			want: testLeg{Ride, "103S", "104S", "08:15", "08:20", second},
+		},
+		{
+			name: "cancelled", from: "101", to: "104", depart: "07:55",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", canceled),
+			},
+			want: testLeg{Ride, "101S", "104S", "08:10", "08:20", second},
+		},
+		{
+			name: "missing from the replacement period", from: "101", to: "104", depart: "07:55",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("049000_1..S03R", scheduled,
+					testUpdate(at, "101S", "08:10"),
+					testUpdate(at, "103S", "08:15"),
+					testUpdate(at, "104S", "08:20")),
+			},
+			replaceUntil: "09:00",
+			want:         testLeg{Ride, "101S", "104S", "08:10", "08:20", second},
+			wantLive:     true,
+		},
+		{
+			name: "added", from: "101", to: "104", depart: "08:01",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048300_1..S", added,
+					testUpdate(at, "101S", "08:03"),
+					testUpdate(at, "104S", "08:11")),
+			},
+			want:     testLeg{Ride, "101S", "104S", "08:03", "08:11", "048300_1..S"},
+			wantLive: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var until time.Time
+			if tt.replaceUntil != "" {
+				until = at(tt.replaceUntil)
+			}
+			rt := testRealtime(t, p, at(tt.depart), until, tt.trips...)
+
+			its, err := p.PlanRealtime(rt, tt.from, tt.to, at(tt.depart), 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			it := its[len(its)-1]
+			if len(it.Legs) != 1 {
+				t.Fatalf("got %d legs, want 1: %+v", len(it.Legs), it.Legs)
+			}
+			leg, want := it.Legs[0], tt.want
+			if leg.Kind != want.kind || leg.FromStopID != want.from || leg.ToStopID != want.to ||
+				!leg.Departure.Equal(at(want.dep)) || !leg.Arrival.Equal(at(want.arr)) || leg.Trip.ID != want.tripID {
+				t.Errorf("leg = %s %s %s-%s %s %s, want %+v", leg.Kind, leg.FromStopID,
+					leg.Departure.Format("15:04"), leg.ToStopID, leg.Arrival.Format("15:04"), leg.Trip.ID, want)
+			}
+			if leg.Realtime != tt.wantLive {
+				t.Errorf("leg realtime = %t, want %t", leg.Realtime, tt.wantLive)
+			}
+		})
+	}
+}
+
+func TestRecheck(t *testing.T) {
+	p := testPlanner(t)
+	at := testClock(t, p)
+	const (
+		scheduled = transit_realtime.TripDescriptor_SCHEDULED
+		canceled  = transit_realtime.TripDescriptor_CANCELED
+	)
+
+	// 101 to 104 on the 08:00, then a walk to 201 for the 08:15
+	its, err := p.Plan("101", "204", at("08:00"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	planned := its[len(its)-1]
+	if len(planned.Legs) != 3 {
+		t.Fatalf("expected 3 legs, got %+v", planned.Legs)
+	}
+
+	type recheckedLeg struct {
+		dep, arr string
+		live     bool
+		missed   bool
+	}
+	tests := []struct {
+		name  string
+		now   string
+		trips []*transit_realtime.TripUpdate
+
+		want []recheckedLeg
+	}{
+		{
+			name: "on time", now: "07:58",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled,
+					testUpdate(at, "101S", "08:00"),
+					testUpdate(at, "103S", "08:05"),
+					testUpdate(at, "104S", "08:10")),
+			},
+			want: []recheckedLeg{
+				{"08:00", "08:10", true, false},
+				{"08:10", "08:13", false, false},
+				{"08:15", "08:20", false, false},
+			},
+		},
+		{
+			// the walk ends after the 08:15 leaves
+			name: "missed connection", now: "07:58",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled, testUpdate(at, "101S", "08:05")),
+			},
+			want: []recheckedLeg{
+				{"08:05", "08:15", true, false},
+				{"08:15", "08:18", false, false},
+				{"08:15", "08:20", false, true},
+			},
+		},
+		{
+			name: "cancelled", now: "07:58",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", canceled),
+			},
+			want: []recheckedLeg{
+				{"08:00", "08:10", false, true},
+				{"08:10", "08:13", false, false},
+				{"08:15", "08:20", false, false},
+			},
+		},
+		{
+			name: "skipping the transfer", now: "07:58",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled,
+					testUpdate(at, "101S", "08:00"),
+					testUpdate(at, "103S", "08:05"),
+					testUpdate(at, "104S", "")),
+			},
+			want: []recheckedLeg{
+				{"08:00", "08:10", true, true},
+				{"08:10", "08:13", false, false},
+				{"08:15", "08:20", false, false},
+			},
+		},
+		{
+			// the train no longer lists 101 before the rider got there
+			name: "left early", now: "07:58",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled,
+					testUpdate(at, "103S", "08:03"),
+					testUpdate(at, "104S", "08:08")),
+			},
+			want: []recheckedLeg{
+				{"08:00", "08:08", true, true},
+				{"08:08", "08:11", false, false},
+				{"08:15", "08:20", false, false},
+			},
+		},
+		{
+			// riders already on board are fine
+			name: "on board", now: "08:02",
+			trips: []*transit_realtime.TripUpdate{
+				testTripUpdate("048000_1..S03R", scheduled,
+					testUpdate(at, "103S", "08:06"),
+					testUpdate(at, "104S", "08:11")),
+			},
+			want: []recheckedLeg{
+				{"08:00", "08:11", true, false},
+				{"08:11", "08:14", false, false},
+				{"08:15", "08:20", false, false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := testRealtime(t, p, at(tt.now), time.Time{}, tt.trips...)
+			it := p.Recheck(planned, rt)
+			if len(it.Legs) != len(tt.want) {
+				t.Fatalf("got %d legs, want %d", len(it.Legs), len(tt.want))
+			}
+			missed := false
+			for i, want := range tt.want {
+				leg := it.Legs[i]
+				if !leg.Departure.Equal(at(want.dep)) || !leg.Arrival.Equal(at(want.arr)) ||
+					leg.Realtime != want.live || leg.Missed != want.missed {
+					t.Errorf("leg %d = %s-%s realtime:%t missed:%t, want %+v", i,
+						leg.Departure.Format("15:04"), leg.Arrival.Format("15:04"), leg.Realtime, leg.Missed, want)
+				}
+				missed = missed || want.missed
+			}
+			if it.Missed() != missed {
+				t.Errorf("Missed() = %t, want %t", it.Missed(), missed)
+			}
+			if !it.Departure.Equal(it.Legs[0].Departure) || !it.Arrival.Equal(it.Legs[2].Arrival) {
+				t.Errorf("itinerary runs %s to %s", it.Departure, it.Arrival)
+			}
+			// the planned itinerary is left alone
+			if planned.Legs[0].Realtime || planned.Legs[2].Missed {
+				t.Error("Recheck changed the planned itinerary")
+			}
+		})
+	}
+}
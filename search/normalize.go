@@ -0,0 +1,129 @@
+package search
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// abbreviations expands the short forms used in MTA stop names so that
+// "42 St", "42nd Street" and "forty second street" all look alike.
+var abbreviations = map[string]string{
+	"st":    "street",
+	"sts":   "streets",
+	"av":    "avenue",
+	"ave":   "avenue",
+	"avs":   "avenues",
+	"sq":    "square",
+	"pl":    "place",
+	"rd":    "road",
+	"blvd":  "boulevard",
+	"pkwy":  "parkway",
+	"hwy":   "highway",
+	"hts":   "heights",
+	"ctr":   "center",
+	"jct":   "junction",
+	"ft":    "fort",
+	"bklyn": "brooklyn",
+	"e":     "east",
+	"w":     "west",
+	"n":     "north",
+	"s":     "south",
+	"&":     "and",
+}
+
+var numberWords = map[string]int{
+	"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+	"eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14,
+	"fifteen": 15, "sixteen": 16, "seventeen": 17, "eighteen": 18,
+	"nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40,
+	"fifty": 50, "sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+	"sixth": 6, "seventh": 7, "eighth": 8, "ninth": 9, "tenth": 10,
+	"eleventh": 11, "twelfth": 12, "thirteenth": 13, "fourteenth": 14,
+	"fifteenth": 15, "sixteenth": 16, "seventeenth": 17,
+	"eighteenth": 18, "nineteenth": 19, "twentieth": 20, "thirtieth": 30,
+	"fortieth": 40, "fiftieth": 50, "sixtieth": 60, "seventieth": 70,
+	"eightieth": 80, "ninetieth": 90,
+}
+
+// normalize lowercases a name, strips punctuation and ordinal suffixes,
+// expands abbreviations and spells numbers as digits, returning its tokens.
+func normalize(name string) []string {
+	name = strings.ToLower(name)
+	name = strings.Replace(name, "&", " & ", -1)
+	fields := strings.FieldsFunc(name, func(r rune) bool {
+		return r != '&' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var tokens []string
+	for _, f := range fields {
+		f = stripOrdinal(f)
+		if full, ok := abbreviations[f]; ok {
+			f = full
+		}
+		tokens = append(tokens, f)
+	}
+	return joinNumbers(tokens)
+}
+
+// stripOrdinal turns "42nd" into "42".
+func stripOrdinal(tok string) string {
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		num := strings.TrimSuffix(tok, suffix)
+		if num == tok || num == "" {
+			continue
+		}
+		if _, err := strconv.Atoi(num); err == nil {
+			return num
+		}
+	}
+	return tok
+}
+
+// joinNumbers collapses runs of spelled out numbers like "one hundred
+// twenty fifth" or "one twenty fifth" into "125".
+func joinNumbers(tokens []string) []string {
+	var (
+		out     []string
+		num     int
+		inNum   bool
+		lastOne bool
+	)
+	flush := func() {
+		if inNum {
+			out = append(out, strconv.Itoa(num))
+		}
+		num, inNum, lastOne = 0, false, false
+	}
+	for _, tok := range tokens {
+		if tok == "hundred" && inNum {
+			num *= 100
+			lastOne = false
+			continue
+		}
+		n, ok := numberWords[tok]
+		if !ok {
+			flush()
+			out = append(out, tok)
+			continue
+		}
+		// "one twenty fifth" is said for 125th
+		if inNum && lastOne && num < 10 && n >= 10 {
+			num = num*100 + n
+			lastOne = false
+			continue
+		}
+		// "twenty fifth" adds up but "one two" is two numbers
+		if inNum && (n >= 10 && num%100 != 0 || n < 10 && lastOne) {
+			flush()
+		}
+		num += n
+		inNum = true
+		lastOne = n < 10
+	}
+	flush()
+	return out
+}
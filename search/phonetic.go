@@ -0,0 +1,131 @@
+package search
+
+import "strings"
+
+// phonetic encodes a word with a simplified Metaphone so that words that
+// sound alike, like "Hoyt" and "Hoit", share a code. Numbers are returned
+// unchanged.
+func phonetic(word string) string {
+	w := []rune(strings.ToUpper(word))
+	if len(w) == 0 {
+		return ""
+	}
+	if w[0] >= '0' && w[0] <= '9' {
+		return word
+	}
+
+	// silent leading letters
+	if len(w) > 1 {
+		switch string(w[:2]) {
+		case "KN", "GN", "PN", "AE", "WR":
+			w = w[1:]
+		case "WH":
+			w = append([]rune{'W'}, w[2:]...)
+		}
+	}
+	if w[0] == 'X' {
+		w[0] = 'S'
+	}
+
+	at := func(i int) rune {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+	next := func(i int, s string) bool {
+		return strings.HasPrefix(string(w[i:]), s)
+	}
+
+	var code strings.Builder
+	for i, c := range w {
+		if c == at(i-1) && c != 'C' {
+			continue
+		}
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if i == 0 {
+				code.WriteRune('A')
+			}
+		case 'B':
+			if !(i == len(w)-1 && at(i-1) == 'M') {
+				code.WriteRune('B')
+			}
+		case 'C':
+			switch {
+			case next(i, "CIA"), next(i, "CH"):
+				code.WriteRune('X')
+			case next(i, "CI"), next(i, "CE"), next(i, "CY"):
+				if at(i-1) != 'S' {
+					code.WriteRune('S')
+				}
+			default:
+				code.WriteRune('K')
+			}
+		case 'D':
+			if next(i, "DGE") || next(i, "DGI") || next(i, "DGY") {
+				code.WriteRune('J')
+			} else {
+				code.WriteRune('T')
+			}
+		case 'G':
+			switch {
+			case next(i, "GH") && i+2 < len(w) && !isVowel(at(i+2)):
+			case next(i, "GN"):
+			case at(i-1) == 'D' && (at(i+1) == 'E' || at(i+1) == 'I' || at(i+1) == 'Y'):
+			case at(i+1) == 'E' || at(i+1) == 'I' || at(i+1) == 'Y':
+				code.WriteRune('J')
+			default:
+				code.WriteRune('K')
+			}
+		case 'H':
+			if isVowel(at(i+1)) && !strings.ContainsRune("CGPST", at(i-1)) {
+				code.WriteRune('H')
+			}
+		case 'K':
+			if at(i-1) != 'C' {
+				code.WriteRune('K')
+			}
+		case 'P':
+			if at(i+1) == 'H' {
+				code.WriteRune('F')
+			} else {
+				code.WriteRune('P')
+			}
+		case 'Q':
+			code.WriteRune('K')
+		case 'S':
+			if next(i, "SH") || next(i, "SIO") || next(i, "SIA") {
+				code.WriteRune('X')
+			} else {
+				code.WriteRune('S')
+			}
+		case 'T':
+			switch {
+			case next(i, "TIA"), next(i, "TIO"):
+				code.WriteRune('X')
+			case next(i, "TH"):
+				code.WriteRune('0')
+			case !next(i, "TCH"):
+				code.WriteRune('T')
+			}
+		case 'V':
+			code.WriteRune('F')
+		case 'W', 'Y':
+			if isVowel(at(i + 1)) {
+				code.WriteRune(c)
+			}
+		case 'X':
+			code.WriteString("KS")
+		case 'Z':
+			code.WriteRune('S')
+		case 'F', 'J', 'L', 'M', 'N', 'R':
+			code.WriteRune(c)
+		}
+	}
+	return code.String()
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("AEIOU", r)
+}
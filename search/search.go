@@ -0,0 +1,272 @@
+// Package search finds subway stations by name, tolerating abbreviations,
+// typos and words that merely sound right, as voice assistants tend to
+// produce.
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jprobinson/gtfs"
+)
+
+// MinScore is the lowest score a station may have to be returned.
+var MinScore = 0.6
+
+// Result is a station matching a query.
+type Result struct {
+	StopID string
+	Name   string
+	// Lines are the routes serving the stop, sorted.
+	Lines []string
+
+	// Score ranks how well the station matched, 1 being an exact match.
+	Score float64
+	// Matched is the station name or synonym that matched the query.
+	Matched string
+}
+
+// Index holds the names of every station for searching.
+type Index struct {
+	entries []entry
+	// stop ID => lines
+	lines map[string][]string
+	// stop ID => display name
+	names map[string]string
+}
+
+type entry struct {
+	stopID string
+	name   string
+
+	tokens []string
+	joined string
+	codes  []string
+	code   string
+}
+
+// NewIndex will index the MTAName, DisplayName, PhoneticName and Synonyms of
+// every stop in the given routes.
+func NewIndex(routes map[string]gtfs.Route) *Index {
+	ix := &Index{lines: map[string][]string{}, names: map[string]string{}}
+	seen := map[string]bool{}
+	for line, route := range routes {
+		for _, stop := range route.Stops {
+			ix.lines[stop.ID] = append(ix.lines[stop.ID], line)
+			ix.names[stop.ID] = stop.DisplayName
+
+			names := append([]string{stop.MTAName, stop.DisplayName, stop.PhoneticName}, stop.Synonyms...)
+			for _, name := range names {
+				key := stop.ID + "|" + name
+				if name == "" || seen[key] {
+					continue
+				}
+				seen[key] = true
+				ix.entries = append(ix.entries, newEntry(stop.ID, name))
+			}
+		}
+	}
+	for _, lines := range ix.lines {
+		sort.Strings(lines)
+	}
+	return ix
+}
+
+func newEntry(stopID, name string) entry {
+	e := entry{stopID: stopID, name: name, tokens: normalize(name)}
+	e.joined = strings.Join(e.tokens, " ")
+	for _, tok := range e.tokens {
+		e.codes = append(e.codes, phonetic(tok))
+	}
+	e.code = strings.Join(e.codes, "")
+	return e
+}
+
+var (
+	defaultOnce  sync.Once
+	defaultIndex *Index
+)
+
+// Search will look up a query in an index of NYCSubwayRoutes.
+func Search(query string, limit int) []Result {
+	defaultOnce.Do(func() {
+		defaultIndex = NewIndex(gtfs.NYCSubwayRoutes)
+	})
+	return defaultIndex.Search(query, limit)
+}
+
+// Search will return up to limit stations matching the query, best first.
+// A limit of zero returns every match.
+func (ix *Index) Search(query string, limit int) []Result {
+	q := newEntry("", query)
+	if len(q.tokens) == 0 {
+		return nil
+	}
+
+	best := map[string]Result{}
+	for _, e := range ix.entries {
+		score := q.score(e)
+		if score < MinScore {
+			continue
+		}
+		if cur, ok := best[e.stopID]; ok && cur.Score >= score {
+			continue
+		}
+		best[e.stopID] = Result{
+			StopID:  e.stopID,
+			Name:    ix.names[e.stopID],
+			Lines:   ix.lines[e.stopID],
+			Score:   score,
+			Matched: e.name,
+		}
+	}
+
+	out := make([]Result, 0, len(best))
+	for _, r := range best {
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].StopID < out[j].StopID
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// score rates how well the query q matches the entry e, between 0 and 1.
+func (q entry) score(e entry) float64 {
+	if q.joined == e.joined {
+		return 1
+	}
+
+	// how well each query word is matched by some word of the name
+	var sum float64
+	for i, qt := range q.tokens {
+		var tokBest float64
+		for j, et := range e.tokens {
+			var s float64
+			switch {
+			case qt == et:
+				s = 1
+			case len(qt) >= 3 && strings.HasPrefix(et, qt):
+				s = 0.9
+			case q.codes[i] != "" && q.codes[i] == e.codes[j] && !isNumber(qt):
+				s = 0.85
+			default:
+				s = 0.8 * similarity(qt, et)
+			}
+			if s > tokBest {
+				tokBest = s
+			}
+		}
+		sum += tokBest
+	}
+	score := sum / float64(len(q.tokens))
+	// favor names that do not have many words beyond the query
+	if len(e.tokens) > len(q.tokens) {
+		score *= 0.8 + 0.2*float64(len(q.tokens))/float64(len(e.tokens))
+	}
+
+	if !q.numbersIn(e) {
+		return score
+	}
+	// whole names that sound alike, like "dekalb" and "de kalb"
+	if q.code != "" && q.code == e.code && score < 0.85 {
+		score = 0.85
+	}
+	if s := 0.9 * similarity(q.joined, e.joined); s > score {
+		score = s
+	}
+	return score
+}
+
+// numbersIn reports whether every number in q is also in e.
+func (q entry) numbersIn(e entry) bool {
+	for _, qt := range q.tokens {
+		if !isNumber(qt) {
+			continue
+		}
+		found := false
+		for _, et := range e.tokens {
+			if et == qt {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// similarity is 1 minus the edit distance between a and b relative to the
+// longer of the two. Numbers must match exactly, since "42" is not nearly
+// "43".
+func similarity(a, b string) float64 {
+	if isNumber(a) || isNumber(b) {
+		if a == b {
+			return 1
+		}
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Times Sq - 42 St", []string{"times", "square", "42", "street"}},
+		{"42nd St", []string{"42", "street"}},
+		{"forty second street", []string{"42", "street"}},
+		{"125th", []string{"125"}},
+		{"one twenty fifth street", []string{"125", "street"}},
+		{"one hundred twenty fifth street", []string{"125", "street"}},
+		{"one oh three", []string{"1", "oh", "3"}},
+		{"two three", []string{"2", "3"}},
+		{"W 4 St-Wash Sq", []string{"west", "4", "street", "wash", "square"}},
+		{"DeKalb Av", []string{"dekalb", "avenue"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := normalize(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		// want are the stop IDs of the best results, in order
+		want  []string
+		exact bool
+	}{
+		{"exact", "times square", []string{"127", "725", "R16"}, true},
+		{"typo", "tines square", []string{"127", "725", "R16"}, false},
+		{"spelled out number", "one twenty fifth street", []string{"116", "225", "621"}, true},
+		{"abbreviated", "jay st metrotech", []string{"A41", "R29"}, false},
+		{"prefix", "dekalb", []string{"L16", "R30"}, false},
+		{"sounds alike", "de kalb av", []string{"L16", "R30"}, false},
+		{"closest first", "hunters point", []string{"720", "613", "702"}, false},
+		// sounding like "125 street" is not enough when the numbers differ,
+		// so only the 25th streets match
+		{"wrong number", "1 25 street", []string{"R35", "H10"}, false},
+		{"no match", "xyzzy", nil, false},
+		{"empty", "", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range Search(tt.query, len(tt.want)) {
+				got = append(got, r.StopID)
+				if tt.exact && r.Score != 1 {
+					t.Errorf("Search(%q) scored %s %.3f, want an exact match", tt.query, r.StopID, r.Score)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package static
+
+import (
+	"sort"
+	"time"
+)
+
+// ServiceDate returns midnight of the calendar day t falls on in the
+// feed's timezone.
+func (f *Feed) ServiceDate(t time.Time) time.Time {
+	y, m, d := t.In(f.location()).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, f.location())
+}
+
+// RunsOn reports whether the given service operates on the date. Only the
+// date's year, month and day are used, in whatever location it carries, so
+// both ParseDate and ServiceDate results work. Exceptions from
+// calendar_dates.txt take precedence over the weekly calendar.txt pattern.
+func (f *Feed) RunsOn(serviceID string, date time.Time) bool {
+	day := dateKey(date)
+	for _, cd := range f.CalendarDates[serviceID] {
+		if dateKey(cd.Date) == day {
+			return cd.ExceptionType == ServiceAdded
+		}
+	}
+
+	cal, ok := f.Calendars[serviceID]
+	if !ok {
+		return false
+	}
+	if day < dateKey(cal.StartDate) || day > dateKey(cal.EndDate) {
+		return false
+	}
+	return cal.Days[date.Weekday()]
+}
+
+// ActiveServices returns the sorted IDs of every service operating on the
+// given date.
+func (f *Feed) ActiveServices(date time.Time) []string {
+	seen := map[string]bool{}
+	for id := range f.Calendars {
+		seen[id] = false
+	}
+	for id := range f.CalendarDates {
+		seen[id] = false
+	}
+
+	var out []string
+	for id := range seen {
+		if f.RunsOn(id, date) {
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TripsOn returns the trips for a route that operate on the given service
+// date, ordered by their first departure. An empty routeID returns trips for
+// every route.
+func (f *Feed) TripsOn(date time.Time, routeID string) []Trip {
+	active := map[string]bool{}
+	for _, id := range f.ActiveServices(date) {
+		active[id] = true
+	}
+
+	var out []Trip
+	for _, trip := range f.Trips {
+		if routeID != "" && trip.RouteID != routeID {
+			continue
+		}
+		if active[trip.ServiceID] {
+			out = append(out, trip)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ti, tj := f.firstDeparture(out[i].ID), f.firstDeparture(out[j].ID)
+		if ti != tj {
+			return ti < tj
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+func (f *Feed) firstDeparture(tripID string) Time {
+	sts := f.StopTimes[tripID]
+	if len(sts) == 0 {
+		return NoTime
+	}
+	return sts[0].DepartureTime
+}
+
+func (f *Feed) location() *time.Location {
+	if f.Location == nil {
+		return time.UTC
+	}
+	return f.Location
+}
+
+// dateKey flattens the date portion of t into a sortable YYYYMMDD integer.
+func dateKey(t time.Time) int {
+	y, m, d := t.Date()
+	return y*10000 + int(m)*100 + d
+}
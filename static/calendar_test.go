@@ -0,0 +1,78 @@
+package static
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testFeed(t *testing.T) *Feed {
+	t.Helper()
+	feed, err := LoadDir("../testdata/subway")
+	if err != nil {
+		t.Fatalf("unable to load test feed: %s", err)
+	}
+	return feed
+}
+
+func TestActiveServices(t *testing.T) {
+	feed := testFeed(t)
+
+	tests := []struct {
+		name string
+		date string
+		want []string
+	}{
+		{"weekday", "20200218", []string{"WKD"}},
+		{"saturday", "20200215", []string{"SAT"}},
+		{"sunday", "20200216", []string{"SUN"}},
+		// Presidents' Day runs a Sunday schedule
+		{"exception", "20200217", []string{"SUN"}},
+		{"before calendar", "20191231", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := ParseDate(tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := feed.ActiveServices(date); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ActiveServices(%s) = %v, want %v", tt.date, got, tt.want)
+			}
+
+			// dates in the feed's own timezone resolve to the same day
+			local := feed.ServiceDate(date.Add(12 * time.Hour))
+			if got := feed.ActiveServices(local); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ActiveServices(%s) = %v, want %v", local, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunsOn(t *testing.T) {
+	feed := testFeed(t)
+
+	tests := []struct {
+		service string
+		date    string
+		want    bool
+	}{
+		{"WKD", "20200218", true},
+		{"WKD", "20200215", false},
+		{"WKD", "20200217", false},
+		{"SUN", "20200217", true},
+		{"SUN", "20200216", true},
+		{"SAT", "20200216", false},
+		{"WKD", "20210104", false},
+		{"missing", "20200218", false},
+	}
+	for _, tt := range tests {
+		date, err := ParseDate(tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := feed.RunsOn(tt.service, date); got != tt.want {
+			t.Errorf("RunsOn(%s, %s) = %t, want %t", tt.service, tt.date, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package static
+
+import (
+	"sort"
+	"time"
+)
+
+// StationComplex is a group of stations linked by transfers, such as Times
+// Sq-42 St (127, 725, 902, A27 and R16).
+type StationComplex struct {
+	// ID is the lowest station ID in the complex.
+	ID   string
+	Name string
+	// Stations are the IDs of the stations in the complex, sorted.
+	Stations []string
+	// Transfers are the transfers between (and within) the stations of the
+	// complex.
+	Transfers []Transfer
+}
+
+// TransferGraph connects stations by the transfers between them. Platforms
+// are treated as part of their parent station.
+type TransferGraph struct {
+	stops map[string]Stop
+
+	// stop ID => transfers out of the stop, ordered by destination
+	edges map[string][]Transfer
+	// station ID => station ID => shortest direct transfer
+	links map[string]map[string]time.Duration
+	// station ID => complex
+	complexes map[string]*StationComplex
+}
+
+// NewTransferGraph will group stations into complexes using the given
+// transfers. Transfers marked as not possible do not link stations.
+func NewTransferGraph(stops map[string]Stop, transfers []Transfer) *TransferGraph {
+	g := &TransferGraph{
+		stops:     stops,
+		edges:     map[string][]Transfer{},
+		links:     map[string]map[string]time.Duration{},
+		complexes: map[string]*StationComplex{},
+	}
+
+	// union find over stations
+	parent := map[string]string{}
+	var root func(id string) string
+	root = func(id string) string {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		r := root(p)
+		parent[id] = r
+		return r
+	}
+
+	for _, xfer := range transfers {
+		g.edges[xfer.FromStopID] = append(g.edges[xfer.FromStopID], xfer)
+
+		from, to := g.station(xfer.FromStopID), g.station(xfer.ToStopID)
+		if xfer.Type == TransferNotPossible || from == to {
+			continue
+		}
+		if g.links[from] == nil {
+			g.links[from] = map[string]time.Duration{}
+		}
+		if cur, ok := g.links[from][to]; !ok || xfer.MinTransferTime < cur {
+			g.links[from][to] = xfer.MinTransferTime
+		}
+		if rf, rt := root(from), root(to); rf != rt {
+			// keep the lowest ID as the root so it can name the complex
+			if rt < rf {
+				rf, rt = rt, rf
+			}
+			parent[rt] = rf
+		}
+	}
+	for _, edges := range g.edges {
+		sort.SliceStable(edges, func(i, j int) bool {
+			return edges[i].ToStopID < edges[j].ToStopID
+		})
+	}
+
+	for id := range parent {
+		r := root(id)
+		c, ok := g.complexes[r]
+		if !ok {
+			c = &StationComplex{ID: r, Name: stops[r].Name}
+			g.complexes[r] = c
+		}
+		g.complexes[id] = c
+		c.Stations = append(c.Stations, id)
+	}
+	for id, c := range g.complexes {
+		if id != c.ID {
+			continue
+		}
+		// roots are never linked to a parent themselves
+		c.Stations = append(c.Stations, c.ID)
+		sort.Strings(c.Stations)
+		for _, st := range c.Stations {
+			c.Transfers = append(c.Transfers, g.edges[st]...)
+		}
+	}
+	return g
+}
+
+// station resolves a platform to its parent station.
+func (g *TransferGraph) station(stopID string) string {
+	if p := g.stops[stopID].ParentStation; p != "" {
+		return p
+	}
+	return stopID
+}
+
+// Complexes will return every complex of two or more stations, ordered by ID.
+func (g *TransferGraph) Complexes() []StationComplex {
+	var out []StationComplex
+	for id, c := range g.complexes {
+		if id == c.ID {
+			out = append(out, *c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// ComplexOf will return the complex a stop or station belongs to. Stations
+// without transfers to other stations are a complex of their own.
+func (g *TransferGraph) ComplexOf(stopID string) (StationComplex, bool) {
+	st := g.station(stopID)
+	if c, ok := g.complexes[st]; ok {
+		return *c, true
+	}
+	if _, ok := g.stops[st]; !ok && len(g.edges[st]) == 0 {
+		return StationComplex{}, false
+	}
+	return StationComplex{
+		ID:        st,
+		Name:      g.stops[st].Name,
+		Stations:  []string{st},
+		Transfers: g.edges[st],
+	}, true
+}
+
+// Transfers will return the transfers listed out of a stop, falling back to
+// those of its parent station.
+func (g *TransferGraph) Transfers(stopID string) []Transfer {
+	if edges, ok := g.edges[stopID]; ok {
+		return edges
+	}
+	return g.edges[g.station(stopID)]
+}
+
+// Transfer will return the transfer between two stops. Transfers listed in
+// the feed are preferred, first between the stops themselves and then
+// between their stations. Stations in the same complex without a listed
+// transfer are connected by the quickest chain of transfers between them.
+// False is returned if the transfer is not possible.
+func (g *TransferGraph) Transfer(from, to string) (Transfer, bool) {
+	for _, pair := range [][2]string{{from, to}, {g.station(from), g.station(to)}} {
+		for _, xfer := range g.edges[pair[0]] {
+			if xfer.ToStopID == pair[1] {
+				return xfer, xfer.Type != TransferNotPossible
+			}
+		}
+	}
+
+	fromSt, toSt := g.station(from), g.station(to)
+	if fromSt == toSt {
+		return Transfer{FromStopID: from, ToStopID: to, Type: TransferRecommended}, true
+	}
+	c, ok := g.complexes[fromSt]
+	if !ok || g.complexes[toSt] != c {
+		return Transfer{}, false
+	}
+	dur, ok := g.shortest(c, fromSt, toSt)
+	if !ok {
+		return Transfer{}, false
+	}
+	return Transfer{
+		FromStopID:      from,
+		ToStopID:        to,
+		Type:            TransferMinTime,
+		MinTransferTime: dur,
+	}, true
+}
+
+// TransferTime will return the minimum time needed to transfer between two
+// stops, or false if it is not possible.
+func (g *TransferGraph) TransferTime(from, to string) (time.Duration, bool) {
+	xfer, ok := g.Transfer(from, to)
+	return xfer.MinTransferTime, ok
+}
+
+// shortest finds the quickest chain of transfers between two stations in a
+// complex. Complexes are small, so a simple Dijkstra will do.
+func (g *TransferGraph) shortest(c *StationComplex, from, to string) (time.Duration, bool) {
+	dist := map[string]time.Duration{from: 0}
+	done := map[string]bool{}
+	for {
+		cur, found := "", false
+		for _, st := range c.Stations {
+			d, ok := dist[st]
+			if !ok || done[st] {
+				continue
+			}
+			if !found || d < dist[cur] {
+				cur, found = st, true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+		if cur == to {
+			return dist[cur], true
+		}
+		done[cur] = true
+		for next, d := range g.links[cur] {
+			if nd := dist[cur] + d; !done[next] {
+				if old, ok := dist[next]; !ok || nd < old {
+					dist[next] = nd
+				}
+			}
+		}
+	}
+}
+
+// TransferGraph will return the graph of the feed's transfers, building it
+// on first use.
+func (f *Feed) TransferGraph() *TransferGraph {
+	f.graphOnce.Do(func() {
+		f.graph = NewTransferGraph(f.Stops, f.Transfers)
+	})
+	return f.graph
+}
+
+// ComplexOf will return the station complex a stop belongs to.
+func (f *Feed) ComplexOf(stopID string) (StationComplex, bool) {
+	return f.TransferGraph().ComplexOf(stopID)
+}
+
+// TransferTime will return the minimum time needed to transfer between two
+// stops, or false if it is not possible.
+func (f *Feed) TransferTime(from, to string) (time.Duration, bool) {
+	return f.TransferGraph().TransferTime(from, to)
+}
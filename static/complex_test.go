@@ -0,0 +1,171 @@
+package static
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFeedComplexOf(t *testing.T) {
+	feed := testFeed(t)
+	xfers := []Transfer{
+		{FromStopID: "104", ToStopID: "201", Type: TransferMinTime, MinTransferTime: 3 * time.Minute},
+		{FromStopID: "201", ToStopID: "104", Type: TransferMinTime, MinTransferTime: 3 * time.Minute},
+	}
+	linked := StationComplex{ID: "104", Name: "231 St", Stations: []string{"104", "201"}, Transfers: xfers}
+
+	tests := []struct {
+		stopID string
+		want   StationComplex
+		wantOK bool
+	}{
+		{"104", linked, true},
+		{"104S", linked, true},
+		{"201N", linked, true},
+		{"101N", StationComplex{ID: "101", Name: "Van Cortlandt Park - 242 St", Stations: []string{"101"}}, true},
+		{"missing", StationComplex{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := feed.ComplexOf(tt.stopID)
+		if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ComplexOf(%q) = %+v, %t, want %+v, %t", tt.stopID, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	if got := feed.TransferGraph().Complexes(); !reflect.DeepEqual(got, []StationComplex{linked}) {
+		t.Errorf("Complexes() = %+v, want %+v", got, []StationComplex{linked})
+	}
+}
+
+func TestFeedTransferTime(t *testing.T) {
+	feed := testFeed(t)
+	tests := []struct {
+		from, to string
+		want     time.Duration
+		wantOK   bool
+	}{
+		{"104", "201", 3 * time.Minute, true},
+		// platforms use their station's transfers
+		{"104S", "201N", 3 * time.Minute, true},
+		{"201S", "104N", 3 * time.Minute, true},
+		// changing platforms within a station
+		{"104N", "104S", 0, true},
+		{"101S", "104S", 0, false},
+		{"101S", "missing", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := feed.TransferTime(tt.from, tt.to)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("TransferTime(%q, %q) = %s, %t, want %s, %t", tt.from, tt.to, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// testTimesSquare is a transfer graph shaped like Times Sq-42 St, where
+// some stations are only reachable through others.
+func testTimesSquare() *TransferGraph {
+	stops := map[string]Stop{
+		"127":  {ID: "127", Name: "Times Sq-42 St"},
+		"127N": {ID: "127N", Name: "Times Sq-42 St", ParentStation: "127"},
+		"127S": {ID: "127S", Name: "Times Sq-42 St", ParentStation: "127"},
+		"725":  {ID: "725", Name: "Times Sq-42 St"},
+		"902":  {ID: "902", Name: "Times Sq-42 St"},
+		"A27":  {ID: "A27", Name: "42 St-Port Authority Bus Terminal"},
+		"R16":  {ID: "R16", Name: "Times Sq-42 St"},
+		"631":  {ID: "631", Name: "Grand Central-42 St"},
+	}
+	xfer := func(from, to string, typ TransferType, secs int) Transfer {
+		return Transfer{FromStopID: from, ToStopID: to, Type: typ, MinTransferTime: time.Duration(secs) * time.Second}
+	}
+	return NewTransferGraph(stops, []Transfer{
+		// listed so the lowest ID only joins the complex last
+		xfer("A27", "R16", TransferMinTime, 300),
+		xfer("R16", "725", TransferMinTime, 120),
+		xfer("725", "R16", TransferMinTime, 120),
+		xfer("902", "R16", TransferMinTime, 60),
+		xfer("R16", "902", TransferMinTime, 300),
+		xfer("127", "902", TransferMinTime, 60),
+		xfer("902", "127", TransferMinTime, 60),
+		xfer("127", "725", TransferMinTime, 180),
+		xfer("725", "127", TransferMinTime, 180),
+		xfer("127N", "725", TransferMinTime, 240),
+		xfer("127", "127", TransferMinTime, 120),
+		xfer("631", "127", TransferNotPossible, 0),
+	})
+}
+
+func TestTransferGraphComplexes(t *testing.T) {
+	g := testTimesSquare()
+
+	got := g.Complexes()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 complex, got %+v", got)
+	}
+	c := got[0]
+	if c.ID != "127" || c.Name != "Times Sq-42 St" {
+		t.Errorf("complex is %s %q, want 127 %q", c.ID, c.Name, "Times Sq-42 St")
+	}
+	if want := []string{"127", "725", "902", "A27", "R16"}; !reflect.DeepEqual(c.Stations, want) {
+		t.Errorf("stations = %v, want %v", c.Stations, want)
+	}
+	// the complex lists transfers by station, then destination
+	var pairs []string
+	for _, x := range c.Transfers {
+		pairs = append(pairs, x.FromStopID+">"+x.ToStopID)
+	}
+	want := []string{"127>127", "127>725", "127>902", "725>127", "725>R16", "902>127", "902>R16", "A27>R16", "R16>725", "R16>902"}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("transfers = %v, want %v", pairs, want)
+	}
+
+	for _, id := range []string{"127S", "725", "A27", "R16"} {
+		if got, ok := g.ComplexOf(id); !ok || got.ID != "127" {
+			t.Errorf("ComplexOf(%q) = %s, %t, want 127", id, got.ID, ok)
+		}
+	}
+	// a transfer that is not possible does not join complexes
+	if got, ok := g.ComplexOf("631"); !ok || got.ID != "631" || len(got.Stations) != 1 {
+		t.Errorf("ComplexOf(631) = %+v, %t, want a complex of its own", got, ok)
+	}
+}
+
+func TestTransferGraphTransfer(t *testing.T) {
+	g := testTimesSquare()
+	tests := []struct {
+		name     string
+		from, to string
+		want     time.Duration
+		wantType TransferType
+		wantOK   bool
+	}{
+		{"listed", "127", "725", 180 * time.Second, TransferMinTime, true},
+		{"listed for the platform", "127N", "725", 240 * time.Second, TransferMinTime, true},
+		{"listed for the station", "127S", "725", 180 * time.Second, TransferMinTime, true},
+		{"listed within the station", "127N", "127S", 120 * time.Second, TransferMinTime, true},
+		{"quickest chain", "127", "R16", 120 * time.Second, TransferMinTime, true},
+		{"longer chain", "A27", "127", 600 * time.Second, TransferMinTime, true},
+		// through R16 first, then quicker through 127
+		{"improved chain", "725", "902", 240 * time.Second, TransferMinTime, true},
+		// A27 is only listed as a way out
+		{"one way", "127", "A27", 0, 0, false},
+		{"not possible", "631", "127", 0, TransferNotPossible, false},
+		{"another complex", "127", "631", 0, 0, false},
+		{"unknown", "127", "missing", 0, 0, false},
+	}
+	for _, tt := range tests {
+		xfer, ok := g.Transfer(tt.from, tt.to)
+		if ok != tt.wantOK || xfer.MinTransferTime != tt.want || xfer.Type != tt.wantType {
+			t.Errorf("%s: Transfer(%q, %q) = %+v, %t, want %s of type %d, %t",
+				tt.name, tt.from, tt.to, xfer, ok, tt.want, tt.wantType, tt.wantOK)
+		}
+		if got, ok := g.TransferTime(tt.from, tt.to); ok != tt.wantOK || got != tt.want {
+			t.Errorf("%s: TransferTime(%q, %q) = %s, %t", tt.name, tt.from, tt.to, got, ok)
+		}
+	}
+
+	// chained transfers are between the stops asked for
+	xfer, _ := g.Transfer("127S", "R16")
+	if xfer.FromStopID != "127S" || xfer.ToStopID != "R16" {
+		t.Errorf("chained transfer is from %q to %q, want 127S to R16", xfer.FromStopID, xfer.ToStopID)
+	}
+}
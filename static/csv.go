@@ -0,0 +1,154 @@
+package static
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseError describes a malformed row or value in a feed file.
+type ParseError struct {
+	File   string
+	Line   int
+	Column string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %s: %s", e.File, e.Line, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// table walks the rows of a single GTFS CSV file. Value parsing errors are
+// sticky: the first one stops iteration and is reported by err.
+type table struct {
+	name string
+	r    *csv.Reader
+	cols map[string]int
+	rec  []string
+	line int
+	fail error
+}
+
+func newTable(name string, rd io.Reader, required ...string) (*table, error) {
+	r := csv.NewReader(rd)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	t := &table{name: name, r: r, cols: map[string]int{}, line: 1}
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, &ParseError{File: name, Line: 1, Err: fmt.Errorf("missing header")}
+	}
+	if err != nil {
+		return nil, &ParseError{File: name, Line: 1, Err: err}
+	}
+	for idx, val := range header {
+		// some producers prefix the header with a UTF-8 byte order mark
+		val = strings.TrimPrefix(val, "\ufeff")
+		t.cols[strings.TrimSpace(val)] = idx
+	}
+	for _, col := range required {
+		if _, ok := t.cols[col]; !ok {
+			return nil, &ParseError{File: name, Line: 1, Column: col, Err: fmt.Errorf("missing required column")}
+		}
+	}
+	return t, nil
+}
+
+func (t *table) next() bool {
+	if t.fail != nil {
+		return false
+	}
+	rec, err := t.r.Read()
+	if err == io.EOF {
+		return false
+	}
+	t.line++
+	if err != nil {
+		t.fail = &ParseError{File: t.name, Line: t.line, Err: err}
+		return false
+	}
+	t.rec = rec
+	return true
+}
+
+func (t *table) err() error {
+	return t.fail
+}
+
+func (t *table) setErr(col string, err error) {
+	if t.fail == nil {
+		t.fail = &ParseError{File: t.name, Line: t.line, Column: col, Err: err}
+	}
+}
+
+func (t *table) str(col string) string {
+	idx, ok := t.cols[col]
+	if !ok || idx >= len(t.rec) {
+		return ""
+	}
+	return strings.TrimSpace(t.rec[idx])
+}
+
+func (t *table) integer(col string) int {
+	s := t.str(col)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		t.setErr(col, err)
+	}
+	return n
+}
+
+func (t *table) float(col string) float64 {
+	s := t.str(col)
+	if s == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		t.setErr(col, err)
+	}
+	return f
+}
+
+func (t *table) boolean(col string) bool {
+	switch s := t.str(col); s {
+	case "", "0":
+		return false
+	case "1":
+		return true
+	default:
+		t.setErr(col, fmt.Errorf("invalid boolean %q", s))
+		return false
+	}
+}
+
+func (t *table) stopTime(col string) Time {
+	st, err := ParseTime(t.str(col))
+	if err != nil {
+		t.setErr(col, err)
+	}
+	return st
+}
+
+func (t *table) date(col string) time.Time {
+	d, err := ParseDate(t.str(col))
+	if err != nil {
+		t.setErr(col, err)
+	}
+	return d
+}
@@ -0,0 +1,332 @@
+// Package static parses static GTFS feeds, like the NYCT subway's
+// google_transit.zip (found here: http://web.mta.info/developers/data/nyct/subway/google_transit.zip),
+// into typed Go structs.
+package static
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ErrMissingFile is returned when a file required by the GTFS spec is not
+// present in the feed.
+var ErrMissingFile = errors.New("missing required file")
+
+// Load will read a GTFS feed from either a zip file or a directory holding
+// the extracted text files.
+func Load(name string) (*Feed, error) {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to open feed", err)
+	}
+	if fi.IsDir() {
+		return LoadDir(name)
+	}
+
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to open feed zip", err)
+	}
+	defer zr.Close()
+	return load(zipOpener(&zr.Reader))
+}
+
+// LoadZip will read a GTFS feed from zip data, such as a downloaded
+// google_transit.zip held in memory.
+func LoadZip(r io.ReaderAt, size int64) (*Feed, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read feed zip", err)
+	}
+	return load(zipOpener(zr))
+}
+
+// LoadDir will read a GTFS feed from a directory of extracted text files.
+func LoadDir(dir string) (*Feed, error) {
+	return load(func(name string) (io.ReadCloser, error) {
+		return os.Open(filepath.Join(dir, name))
+	})
+}
+
+// opener returns the contents of a feed file. Files that do not exist must
+// be reported with an error satisfying os.IsNotExist.
+type opener func(name string) (io.ReadCloser, error)
+
+func zipOpener(zr *zip.Reader) opener {
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		// feeds are sometimes zipped with a top level directory
+		files[path.Base(f.Name)] = f
+	}
+	return func(name string) (io.ReadCloser, error) {
+		f, ok := files[name]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return f.Open()
+	}
+}
+
+func load(open opener) (*Feed, error) {
+	feed := &Feed{
+		Stops:         map[string]Stop{},
+		Routes:        map[string]Route{},
+		Trips:         map[string]Trip{},
+		StopTimes:     map[string][]StopTime{},
+		Calendars:     map[string]Calendar{},
+		CalendarDates: map[string][]CalendarDate{},
+		Shapes:        map[string]Shape{},
+	}
+
+	files := []struct {
+		name     string
+		required bool
+		parse    func(*Feed, io.Reader) error
+	}{
+		{"agency.txt", true, parseAgencies},
+		{"stops.txt", true, parseStops},
+		{"routes.txt", true, parseRoutes},
+		{"trips.txt", true, parseTrips},
+		{"stop_times.txt", true, parseStopTimes},
+		{"calendar.txt", false, parseCalendars},
+		{"calendar_dates.txt", false, parseCalendarDates},
+		{"transfers.txt", false, parseTransfers},
+		{"shapes.txt", false, parseShapes},
+	}
+	for _, file := range files {
+		rc, err := open(file.name)
+		if os.IsNotExist(err) {
+			if file.required {
+				return nil, fmt.Errorf("%w: %s", ErrMissingFile, file.name)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to open %s", err, file.name)
+		}
+		err = file.parse(feed, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(feed.Calendars) == 0 && len(feed.CalendarDates) == 0 {
+		return nil, fmt.Errorf("%w: calendar.txt or calendar_dates.txt", ErrMissingFile)
+	}
+	if len(feed.Agencies) == 0 {
+		return nil, &ParseError{File: "agency.txt", Line: 1, Err: errors.New("no agencies")}
+	}
+
+	// all agencies in a feed are required to share a timezone
+	loc, err := time.LoadLocation(feed.Agencies[0].Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to load agency timezone", err)
+	}
+	feed.Location = loc
+
+	for _, sts := range feed.StopTimes {
+		sort.SliceStable(sts, func(i, j int) bool {
+			return sts[i].StopSequence < sts[j].StopSequence
+		})
+	}
+	for id, shape := range feed.Shapes {
+		pts := shape.Points
+		sort.SliceStable(pts, func(i, j int) bool {
+			return pts[i].Sequence < pts[j].Sequence
+		})
+		feed.Shapes[id] = shape
+	}
+	return feed, nil
+}
+
+func parseAgencies(feed *Feed, r io.Reader) error {
+	t, err := newTable("agency.txt", r, "agency_name", "agency_url", "agency_timezone")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		feed.Agencies = append(feed.Agencies, Agency{
+			ID:       t.str("agency_id"),
+			Name:     t.str("agency_name"),
+			URL:      t.str("agency_url"),
+			Timezone: t.str("agency_timezone"),
+			Lang:     t.str("agency_lang"),
+			Phone:    t.str("agency_phone"),
+		})
+	}
+	return t.err()
+}
+
+func parseStops(feed *Feed, r io.Reader) error {
+	t, err := newTable("stops.txt", r, "stop_id")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		stop := Stop{
+			ID:            t.str("stop_id"),
+			Code:          t.str("stop_code"),
+			Name:          t.str("stop_name"),
+			Desc:          t.str("stop_desc"),
+			Lat:           t.float("stop_lat"),
+			Lon:           t.float("stop_lon"),
+			ZoneID:        t.str("zone_id"),
+			URL:           t.str("stop_url"),
+			LocationType:  LocationType(t.integer("location_type")),
+			ParentStation: t.str("parent_station"),
+		}
+		feed.Stops[stop.ID] = stop
+	}
+	return t.err()
+}
+
+func parseRoutes(feed *Feed, r io.Reader) error {
+	t, err := newTable("routes.txt", r, "route_id", "route_type")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		route := Route{
+			ID:        t.str("route_id"),
+			AgencyID:  t.str("agency_id"),
+			ShortName: t.str("route_short_name"),
+			LongName:  t.str("route_long_name"),
+			Desc:      t.str("route_desc"),
+			Type:      RouteType(t.integer("route_type")),
+			URL:       t.str("route_url"),
+			Color:     t.str("route_color"),
+			TextColor: t.str("route_text_color"),
+		}
+		feed.Routes[route.ID] = route
+	}
+	return t.err()
+}
+
+func parseTrips(feed *Feed, r io.Reader) error {
+	t, err := newTable("trips.txt", r, "route_id", "service_id", "trip_id")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		trip := Trip{
+			ID:          t.str("trip_id"),
+			RouteID:     t.str("route_id"),
+			ServiceID:   t.str("service_id"),
+			Headsign:    t.str("trip_headsign"),
+			DirectionID: t.integer("direction_id"),
+			BlockID:     t.str("block_id"),
+			ShapeID:     t.str("shape_id"),
+		}
+		feed.Trips[trip.ID] = trip
+	}
+	return t.err()
+}
+
+func parseStopTimes(feed *Feed, r io.Reader) error {
+	t, err := newTable("stop_times.txt", r, "trip_id", "stop_id", "stop_sequence")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		st := StopTime{
+			TripID:            t.str("trip_id"),
+			StopID:            t.str("stop_id"),
+			ArrivalTime:       t.stopTime("arrival_time"),
+			DepartureTime:     t.stopTime("departure_time"),
+			StopSequence:      t.integer("stop_sequence"),
+			StopHeadsign:      t.str("stop_headsign"),
+			PickupType:        t.integer("pickup_type"),
+			DropOffType:       t.integer("drop_off_type"),
+			ShapeDistTraveled: t.float("shape_dist_traveled"),
+		}
+		feed.StopTimes[st.TripID] = append(feed.StopTimes[st.TripID], st)
+	}
+	return t.err()
+}
+
+func parseCalendars(feed *Feed, r io.Reader) error {
+	t, err := newTable("calendar.txt", r, "service_id", "start_date", "end_date")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		cal := Calendar{
+			ServiceID: t.str("service_id"),
+			StartDate: t.date("start_date"),
+			EndDate:   t.date("end_date"),
+		}
+		cal.Days[time.Monday] = t.boolean("monday")
+		cal.Days[time.Tuesday] = t.boolean("tuesday")
+		cal.Days[time.Wednesday] = t.boolean("wednesday")
+		cal.Days[time.Thursday] = t.boolean("thursday")
+		cal.Days[time.Friday] = t.boolean("friday")
+		cal.Days[time.Saturday] = t.boolean("saturday")
+		cal.Days[time.Sunday] = t.boolean("sunday")
+		feed.Calendars[cal.ServiceID] = cal
+	}
+	return t.err()
+}
+
+func parseCalendarDates(feed *Feed, r io.Reader) error {
+	t, err := newTable("calendar_dates.txt", r, "service_id", "date", "exception_type")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		cd := CalendarDate{
+			ServiceID:     t.str("service_id"),
+			Date:          t.date("date"),
+			ExceptionType: ExceptionType(t.integer("exception_type")),
+		}
+		if cd.ExceptionType != ServiceAdded && cd.ExceptionType != ServiceRemoved {
+			t.setErr("exception_type", fmt.Errorf("invalid exception type %d", cd.ExceptionType))
+			break
+		}
+		feed.CalendarDates[cd.ServiceID] = append(feed.CalendarDates[cd.ServiceID], cd)
+	}
+	return t.err()
+}
+
+func parseTransfers(feed *Feed, r io.Reader) error {
+	t, err := newTable("transfers.txt", r, "from_stop_id", "to_stop_id", "transfer_type")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		feed.Transfers = append(feed.Transfers, Transfer{
+			FromStopID:      t.str("from_stop_id"),
+			ToStopID:        t.str("to_stop_id"),
+			Type:            TransferType(t.integer("transfer_type")),
+			MinTransferTime: time.Duration(t.integer("min_transfer_time")) * time.Second,
+		})
+	}
+	return t.err()
+}
+
+func parseShapes(feed *Feed, r io.Reader) error {
+	t, err := newTable("shapes.txt", r, "shape_id", "shape_pt_lat", "shape_pt_lon", "shape_pt_sequence")
+	if err != nil {
+		return err
+	}
+	for t.next() {
+		id := t.str("shape_id")
+		shape := feed.Shapes[id]
+		shape.ID = id
+		shape.Points = append(shape.Points, ShapePoint{
+			Lat:          t.float("shape_pt_lat"),
+			Lon:          t.float("shape_pt_lon"),
+			Sequence:     t.integer("shape_pt_sequence"),
+			DistTraveled: t.float("shape_dist_traveled"),
+		})
+		feed.Shapes[id] = shape
+	}
+	return t.err()
+}
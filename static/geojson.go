@@ -0,0 +1,156 @@
+package static
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// FeatureCollection is a GeoJSON feature collection.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature.
+type Feature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Geometry is a GeoJSON geometry. Coordinates are [lon, lat] pairs nested
+// according to Type.
+type Geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// RoutesGeoJSON will build a MultiLineString feature for every route with a
+// line per stop pattern. Patterns follow their shape from shapes.txt when
+// there is one and connect their stops otherwise. Features carry the
+// route_id, route_short_name, route_long_name, route_color and
+// route_text_color of routes.txt.
+func (f *Feed) RoutesGeoJSON() FeatureCollection {
+	// route ID => lines
+	lines := map[string][][][2]float64{}
+	// route ID => line keys already drawn
+	drawn := map[string]map[string]bool{}
+	for _, p := range f.Patterns("") {
+		if drawn[p.RouteID] == nil {
+			drawn[p.RouteID] = map[string]bool{}
+		}
+
+		var (
+			key  string
+			line [][2]float64
+		)
+		if shape, ok := f.Shapes[p.ShapeID]; ok && len(shape.Points) > 1 {
+			key = "shape|" + shape.ID
+			for _, pt := range shape.Points {
+				line = append(line, [2]float64{pt.Lon, pt.Lat})
+			}
+		} else {
+			key = "stops|" + strings.Join(f.StationIDs(p.StopIDs), "|")
+			for _, id := range p.StopIDs {
+				stop := f.Stops[id]
+				line = append(line, [2]float64{stop.Lon, stop.Lat})
+			}
+		}
+		if drawn[p.RouteID][key] || len(line) < 2 {
+			continue
+		}
+		drawn[p.RouteID][key] = true
+		lines[p.RouteID] = append(lines[p.RouteID], line)
+	}
+
+	fc := FeatureCollection{Type: "FeatureCollection", Features: []Feature{}}
+	for _, id := range f.routeIDs() {
+		if len(lines[id]) == 0 {
+			continue
+		}
+		route := f.Routes[id]
+		props := map[string]interface{}{
+			"route_id":         route.ID,
+			"route_short_name": route.ShortName,
+			"route_long_name":  route.LongName,
+		}
+		if route.Color != "" {
+			props["route_color"] = "#" + route.Color
+		}
+		if route.TextColor != "" {
+			props["route_text_color"] = "#" + route.TextColor
+		}
+		fc.Features = append(fc.Features, Feature{
+			Type:       "Feature",
+			ID:         route.ID,
+			Geometry:   Geometry{Type: "MultiLineString", Coordinates: lines[id]},
+			Properties: props,
+		})
+	}
+	return fc
+}
+
+// StopsGeoJSON will build a Point feature for every station with its
+// stop_id, stop_name and the sorted routes serving it.
+func (f *Feed) StopsGeoJSON() FeatureCollection {
+	// station ID => route IDs
+	routes := map[string]map[string]bool{}
+	for _, p := range f.Patterns("") {
+		for _, id := range f.StationIDs(p.StopIDs) {
+			if routes[id] == nil {
+				routes[id] = map[string]bool{}
+			}
+			routes[id][p.RouteID] = true
+		}
+	}
+
+	var ids []string
+	for id, stop := range f.Stops {
+		if stop.ParentStation == "" && stop.LocationType != LocationEntrance {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	fc := FeatureCollection{Type: "FeatureCollection", Features: []Feature{}}
+	for _, id := range ids {
+		stop := f.Stops[id]
+		serving := []string{}
+		for route := range routes[id] {
+			serving = append(serving, route)
+		}
+		sort.Strings(serving)
+		fc.Features = append(fc.Features, Feature{
+			Type:     "Feature",
+			ID:       id,
+			Geometry: Geometry{Type: "Point", Coordinates: [2]float64{stop.Lon, stop.Lat}},
+			Properties: map[string]interface{}{
+				"stop_id":   id,
+				"stop_name": stop.Name,
+				"routes":    serving,
+			},
+		})
+	}
+	return fc
+}
+
+// WriteGeoJSON will encode a feature collection to w.
+func WriteGeoJSON(w io.Writer, fc FeatureCollection) error {
+	if err := json.NewEncoder(w).Encode(fc); err != nil {
+		return fmt.Errorf("%w: unable to write GeoJSON", err)
+	}
+	return nil
+}
+
+func (f *Feed) routeIDs() []string {
+	ids := make([]string, 0, len(f.Routes))
+	for id := range f.Routes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,143 @@
+package static
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRoutesGeoJSON(t *testing.T) {
+	feed := testFeed(t)
+	fc := feed.RoutesGeoJSON()
+	if fc.Type != "FeatureCollection" {
+		t.Errorf("type = %q, want FeatureCollection", fc.Type)
+	}
+
+	var (
+		p101 = [2]float64{-73.898583, 40.889248}
+		bend = [2]float64{-73.9005, 40.887}
+		p103 = [2]float64{-73.90087, 40.884667}
+		p104 = [2]float64{-73.904834, 40.878856}
+		p201 = [2]float64{-73.85062, 40.903125}
+		p204 = [2]float64{-73.854376, 40.898379}
+	)
+	want := []Feature{
+		{
+			Type: "Feature",
+			ID:   "1",
+			Geometry: Geometry{Type: "MultiLineString", Coordinates: [][][2]float64{
+				// the full route follows its shape and the short run, whose
+				// shape is missing, connects its stops
+				{p101, bend, p103, p104},
+				{p101, p103},
+			}},
+			Properties: map[string]interface{}{
+				"route_id":         "1",
+				"route_short_name": "1",
+				"route_long_name":  "Broadway - 7 Avenue Local",
+				"route_color":      "#EE352E",
+			},
+		},
+		{
+			Type: "Feature",
+			ID:   "2",
+			Geometry: Geometry{Type: "MultiLineString", Coordinates: [][][2]float64{
+				{p201, p204},
+				{p204, p201},
+			}},
+			Properties: map[string]interface{}{
+				"route_id":         "2",
+				"route_short_name": "2",
+				"route_long_name":  "7 Avenue Express",
+				"route_color":      "#EE352E",
+			},
+		},
+	}
+	if !reflect.DeepEqual(fc.Features, want) {
+		t.Errorf("RoutesGeoJSON features =\n%+v\nwant\n%+v", fc.Features, want)
+	}
+}
+
+func TestStopsGeoJSON(t *testing.T) {
+	feed := testFeed(t)
+	fc := feed.StopsGeoJSON()
+
+	type station struct {
+		id     string
+		name   string
+		coords [2]float64
+		routes []string
+	}
+	want := []station{
+		{"101", "Van Cortlandt Park - 242 St", [2]float64{-73.898583, 40.889248}, []string{"1"}},
+		{"103", "238 St", [2]float64{-73.90087, 40.884667}, []string{"1"}},
+		{"104", "231 St", [2]float64{-73.904834, 40.878856}, []string{"1"}},
+		{"201", "Wakefield - 241 St", [2]float64{-73.85062, 40.903125}, []string{"2"}},
+		{"204", "Nereid Av", [2]float64{-73.854376, 40.898379}, []string{"2"}},
+	}
+	var got []station
+	for _, f := range fc.Features {
+		if f.Type != "Feature" || f.Geometry.Type != "Point" {
+			t.Errorf("feature %q is a %s %s, want a Feature Point", f.ID, f.Type, f.Geometry.Type)
+		}
+		coords, _ := f.Geometry.Coordinates.([2]float64)
+		routes, _ := f.Properties["routes"].([]string)
+		name, _ := f.Properties["stop_name"].(string)
+		if f.Properties["stop_id"] != f.ID {
+			t.Errorf("feature %q has stop_id %v", f.ID, f.Properties["stop_id"])
+		}
+		got = append(got, station{f.ID, name, coords, routes})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StopsGeoJSON =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestWriteGeoJSON(t *testing.T) {
+	feed := testFeed(t)
+	var buf bytes.Buffer
+	if err := WriteGeoJSON(&buf, feed.RoutesGeoJSON()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			ID       string `json:"id"`
+			Geometry struct {
+				Type        string         `json:"type"`
+				Coordinates [][][2]float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]string `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unable to decode GeoJSON: %s\n%s", err, buf.String())
+	}
+	if got.Type != "FeatureCollection" || len(got.Features) != 2 {
+		t.Fatalf("decoded %s with %d features, want a FeatureCollection with 2", got.Type, len(got.Features))
+	}
+	f := got.Features[0]
+	if f.Type != "Feature" || f.ID != "1" || f.Geometry.Type != "MultiLineString" ||
+		f.Properties["route_color"] != "#EE352E" {
+		t.Errorf("unexpected first feature: %+v", f)
+	}
+	// GeoJSON positions are longitude first
+	if first := f.Geometry.Coordinates[0][0]; first != [2]float64{-73.898583, 40.889248} {
+		t.Errorf("first position = %v, want [-73.898583 40.889248]", first)
+	}
+	if _, ok := f.Properties["route_text_color"]; ok {
+		t.Error("expected no route_text_color for a route without one")
+	}
+
+	// empty collections still encode a features array
+	buf.Reset()
+	if err := WriteGeoJSON(&buf, (&Feed{}).StopsGeoJSON()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := buf.String(); got != "{\"type\":\"FeatureCollection\",\"features\":[]}\n" {
+		t.Errorf("empty collection = %q", got)
+	}
+}
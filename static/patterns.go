@@ -0,0 +1,200 @@
+package static
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeBand is a period of the service day with its own level of service.
+type TimeBand int
+
+const (
+	// LateNight runs from midnight to 6:30am.
+	LateNight TimeBand = iota
+	// AMRush runs from 6:30am to 9:30am.
+	AMRush
+	// Midday runs from 9:30am to 3:30pm.
+	Midday
+	// PMRush runs from 3:30pm to 8pm.
+	PMRush
+	// Evening runs from 8pm to midnight.
+	Evening
+)
+
+var bandNames = map[TimeBand]string{
+	LateNight: "late night",
+	AMRush:    "am rush",
+	Midday:    "midday",
+	PMRush:    "pm rush",
+	Evening:   "evening",
+}
+
+func (b TimeBand) String() string {
+	return bandNames[b]
+}
+
+// BandOf returns the time band a stop time falls in. Times past 24:00 wrap
+// around to the next day.
+func BandOf(t Time) TimeBand {
+	d := time.Duration(t) % (24 * time.Hour)
+	switch {
+	case d < 6*time.Hour+30*time.Minute:
+		return LateNight
+	case d < 9*time.Hour+30*time.Minute:
+		return AMRush
+	case d < 15*time.Hour+30*time.Minute:
+		return Midday
+	case d < 20*time.Hour:
+		return PMRush
+	default:
+		return Evening
+	}
+}
+
+// Pattern is a distinct sequence of stops served by trips of a route in one
+// direction, such as the A to Far Rockaway versus the A to Lefferts Blvd.
+type Pattern struct {
+	RouteID     string
+	DirectionID int
+	// Headsign is the most common headsign of the pattern's trips.
+	Headsign string
+
+	// StopIDs are the stops served in order of travel.
+	StopIDs []string
+	// TripIDs are the trips following the pattern, sorted.
+	TripIDs []string
+	// ShapeID is the shape most of the pattern's trips follow, if any.
+	ShapeID string
+
+	// Days are the days of the week the pattern runs in calendar.txt,
+	// indexed by time.Weekday.
+	Days [7]bool
+	// Bands are the times of day trips leave their origin, in order.
+	Bands []TimeBand
+
+	FirstDeparture Time
+	LastDeparture  Time
+}
+
+// Patterns will group the trips of a route by the stops they serve. An empty
+// routeID returns patterns for every route. Patterns are ordered by route,
+// then the most trips first.
+func (f *Feed) Patterns(routeID string) []Pattern {
+	byKey := map[string]*Pattern{}
+	// pattern key => headsign => count
+	headsigns := map[string]map[string]int{}
+	// pattern key => band => seen
+	bands := map[string]map[TimeBand]bool{}
+	// pattern key => shape ID => count
+	shapes := map[string]map[string]int{}
+
+	for _, trip := range f.Trips {
+		if routeID != "" && trip.RouteID != routeID {
+			continue
+		}
+		sts := f.StopTimes[trip.ID]
+		if len(sts) == 0 {
+			continue
+		}
+
+		var key strings.Builder
+		key.WriteString(trip.RouteID + "|" + strconv.Itoa(trip.DirectionID))
+		for _, st := range sts {
+			key.WriteString("|" + st.StopID)
+		}
+		k := key.String()
+
+		p, ok := byKey[k]
+		if !ok {
+			p = &Pattern{
+				RouteID:        trip.RouteID,
+				DirectionID:    trip.DirectionID,
+				FirstDeparture: NoTime,
+				LastDeparture:  NoTime,
+			}
+			for _, st := range sts {
+				p.StopIDs = append(p.StopIDs, st.StopID)
+			}
+			byKey[k] = p
+			headsigns[k] = map[string]int{}
+			bands[k] = map[TimeBand]bool{}
+			shapes[k] = map[string]int{}
+		}
+		p.TripIDs = append(p.TripIDs, trip.ID)
+		headsigns[k][trip.Headsign]++
+		if trip.ShapeID != "" {
+			shapes[k][trip.ShapeID]++
+		}
+
+		if cal, ok := f.Calendars[trip.ServiceID]; ok {
+			for d, runs := range cal.Days {
+				p.Days[d] = p.Days[d] || runs
+			}
+		}
+		if dep := sts[0].DepartureTime; dep != NoTime {
+			bands[k][BandOf(dep)] = true
+			if p.FirstDeparture == NoTime || dep < p.FirstDeparture {
+				p.FirstDeparture = dep
+			}
+			if dep > p.LastDeparture {
+				p.LastDeparture = dep
+			}
+		}
+	}
+
+	out := make([]Pattern, 0, len(byKey))
+	for k, p := range byKey {
+		sort.Strings(p.TripIDs)
+		p.Headsign = mostCommon(headsigns[k])
+		p.ShapeID = mostCommon(shapes[k])
+		for b := LateNight; b <= Evening; b++ {
+			if bands[k][b] {
+				p.Bands = append(p.Bands, b)
+			}
+		}
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].RouteID != out[j].RouteID {
+			return out[i].RouteID < out[j].RouteID
+		}
+		if len(out[i].TripIDs) != len(out[j].TripIDs) {
+			return len(out[i].TripIDs) > len(out[j].TripIDs)
+		}
+		if out[i].DirectionID != out[j].DirectionID {
+			return out[i].DirectionID < out[j].DirectionID
+		}
+		return strings.Join(out[i].StopIDs, "|") < strings.Join(out[j].StopIDs, "|")
+	})
+	return out
+}
+
+// StationIDs will map stop IDs, like the platforms of a Pattern, to their
+// parent stations. Stops without a parent are kept as they are.
+func (f *Feed) StationIDs(stopIDs []string) []string {
+	out := make([]string, len(stopIDs))
+	for i, id := range stopIDs {
+		out[i] = id
+		if parent := f.Stops[id].ParentStation; parent != "" {
+			out[i] = parent
+		}
+	}
+	return out
+}
+
+// mostCommon returns the key with the highest count, breaking ties by the
+// lowest key.
+func mostCommon(counts map[string]int) string {
+	var (
+		out  string
+		best = -1
+	)
+	for k, n := range counts {
+		if n > best || (n == best && k < out) {
+			out, best = k, n
+		}
+	}
+	return out
+}
@@ -0,0 +1,134 @@
+package static
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBandOf(t *testing.T) {
+	tests := []struct {
+		in   string
+		want TimeBand
+	}{
+		{"00:00:00", LateNight},
+		{"06:29:59", LateNight},
+		{"06:30:00", AMRush},
+		{"09:29:59", AMRush},
+		{"09:30:00", Midday},
+		{"15:30:00", PMRush},
+		{"19:59:59", PMRush},
+		{"20:00:00", Evening},
+		{"23:59:59", Evening},
+		{"24:30:00", LateNight},
+		{"31:00:00", AMRush},
+	}
+	for _, tt := range tests {
+		st, err := ParseTime(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := BandOf(st); got != tt.want {
+			t.Errorf("BandOf(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	feed := testFeed(t)
+	weekdays := [7]bool{false, true, true, true, true, true, false}
+	everyDay := [7]bool{true, true, true, true, true, true, true}
+
+	tests := []struct {
+		routeID string
+		want    []Pattern
+	}{
+		{
+			// the short run to 103 splits off from the full route and
+			// sorts after it with fewer trips
+			routeID: "1",
+			want: []Pattern{
+				{
+					RouteID: "1", DirectionID: 1, Headsign: "South Ferry",
+					ShapeID: "1..S03R",
+					StopIDs: []string{"101S", "103S", "104S"},
+					TripIDs: []string{
+						"AFA19GEN-1037-Saturday-00_054000_1..S03R",
+						"AFA19GEN-1037-Sunday-00_054000_1..S03R",
+						"AFA19GEN-1037-Weekday-00_048000_1..S03R",
+						"AFA19GEN-1037-Weekday-00_049000_1..S03R",
+						"AFA19GEN-1037-Weekday-00_050000_1..S03R",
+						"AFA19GEN-1037-Weekday-00_147000_1..S03R",
+					},
+					Days:           everyDay,
+					Bands:          []TimeBand{LateNight, AMRush},
+					FirstDeparture: testTime(t, "08:00:00"),
+					LastDeparture:  testTime(t, "24:30:00"),
+				},
+				{
+					RouteID: "1", DirectionID: 1, Headsign: "Chambers St",
+					ShapeID:        "1..S01R",
+					StopIDs:        []string{"101S", "103S"},
+					TripIDs:        []string{"AFA19GEN-1037-Weekday-00_049000_1..S01R"},
+					Days:           weekdays,
+					Bands:          []TimeBand{AMRush},
+					FirstDeparture: testTime(t, "08:10:00"),
+					LastDeparture:  testTime(t, "08:10:00"),
+				},
+			},
+		},
+		{
+			// each direction is its own pattern
+			routeID: "2",
+			want: []Pattern{
+				{
+					RouteID: "2", DirectionID: 1, Headsign: "Flatbush Av",
+					StopIDs: []string{"201S", "204S"},
+					TripIDs: []string{
+						"AFA19GEN-2047-Weekday-00_049200_2..S01R",
+						"AFA19GEN-2047-Weekday-00_049500_2..S01R",
+					},
+					Days:           weekdays,
+					Bands:          []TimeBand{AMRush},
+					FirstDeparture: testTime(t, "08:12:00"),
+					LastDeparture:  testTime(t, "08:15:00"),
+				},
+				{
+					RouteID: "2", DirectionID: 0, Headsign: "Wakefield - 241 St",
+					StopIDs:        []string{"204N", "201N"},
+					TripIDs:        []string{"AFA19GEN-2047-Weekday-00_060000_2..N01R"},
+					Days:           weekdays,
+					Bands:          []TimeBand{Midday},
+					FirstDeparture: testTime(t, "10:00:00"),
+					LastDeparture:  testTime(t, "10:00:00"),
+				},
+			},
+		},
+		{routeID: "missing", want: []Pattern{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.routeID, func(t *testing.T) {
+			got := feed.Patterns(tt.routeID)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Patterns(%q) =\n%+v\nwant\n%+v", tt.routeID, got, tt.want)
+			}
+		})
+	}
+
+	// every route, ordered by route
+	var routes []string
+	for _, p := range feed.Patterns("") {
+		routes = append(routes, p.RouteID)
+	}
+	if want := []string{"1", "1", "2", "2"}; !reflect.DeepEqual(routes, want) {
+		t.Errorf("Patterns(\"\") routes = %v, want %v", routes, want)
+	}
+}
+
+func testTime(t *testing.T, s string) Time {
+	t.Helper()
+	st, err := ParseTime(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
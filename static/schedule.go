@@ -0,0 +1,117 @@
+package static
+
+import (
+	"sort"
+	"time"
+)
+
+// AnyDirection can be given to ScheduledDepartures to include trips
+// travelling in both directions.
+const AnyDirection = -1
+
+// Departure is a scheduled departure of a trip from a stop.
+type Departure struct {
+	Trip     Trip
+	StopTime StopTime
+
+	// ServiceDate is the service day the trip belongs to. For service after
+	// midnight this is the day before Time.
+	ServiceDate time.Time
+	Time        time.Time
+}
+
+// ScheduledDepartures will return the scheduled departures from a stop
+// between from (inclusive) and to (exclusive), ordered by time. The stopID
+// may be a platform ("127N") or a parent station ("127"), in which case all
+// of its platforms are included. An empty routeID matches every route and
+// direction may be a GTFS direction_id or AnyDirection. Trips terminating at
+// the stop are left out.
+func (f *Feed) ScheduledDepartures(stopID, routeID string, direction int, from, to time.Time) []Departure {
+	f.buildIndex()
+
+	stopIDs := []string{stopID}
+	for _, stop := range f.Stops {
+		if stop.ParentStation == stopID {
+			stopIDs = append(stopIDs, stop.ID)
+		}
+	}
+
+	// stop times past 24:00:00 belong to the previous service day, and the
+	// GTFS spec allows them to run up to 48 hours past its start
+	var days []time.Time
+	for day := f.ServiceDate(from).AddDate(0, 0, -2); !day.After(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	active := make([]map[string]bool, len(days))
+	for i, day := range days {
+		active[i] = map[string]bool{}
+		for _, id := range f.ActiveServices(day) {
+			active[i][id] = true
+		}
+	}
+
+	var out []Departure
+	for _, id := range stopIDs {
+		for _, st := range f.stopTimesByStop[id] {
+			trip, ok := f.Trips[st.TripID]
+			if !ok {
+				continue
+			}
+			if routeID != "" && trip.RouteID != routeID {
+				continue
+			}
+			if direction != AnyDirection && trip.DirectionID != direction {
+				continue
+			}
+			if st.PickupType == 1 || f.isLastStop(st) {
+				continue
+			}
+			dept := st.DepartureTime
+			if dept == NoTime {
+				dept = st.ArrivalTime
+			}
+			if dept == NoTime {
+				continue
+			}
+
+			for i, day := range days {
+				if !active[i][trip.ServiceID] {
+					continue
+				}
+				t := dept.On(day)
+				if t.Before(from) || !t.Before(to) {
+					continue
+				}
+				out = append(out, Departure{
+					Trip:        trip,
+					StopTime:    st,
+					ServiceDate: day,
+					Time:        t,
+				})
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Time.Equal(out[j].Time) {
+			return out[i].Trip.ID < out[j].Trip.ID
+		}
+		return out[i].Time.Before(out[j].Time)
+	})
+	return out
+}
+
+func (f *Feed) isLastStop(st StopTime) bool {
+	sts := f.StopTimes[st.TripID]
+	return len(sts) > 0 && sts[len(sts)-1].StopSequence == st.StopSequence
+}
+
+func (f *Feed) buildIndex() {
+	f.indexOnce.Do(func() {
+		f.stopTimesByStop = map[string][]StopTime{}
+		for _, sts := range f.StopTimes {
+			for _, st := range sts {
+				f.stopTimesByStop[st.StopID] = append(f.stopTimesByStop[st.StopID], st)
+			}
+		}
+	})
+}
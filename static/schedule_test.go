@@ -0,0 +1,104 @@
+package static
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScheduledDepartures(t *testing.T) {
+	feed := testFeed(t)
+	at := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2020, month, day, hour, min, 0, 0, feed.Location)
+	}
+	type dep struct {
+		TripID      string
+		ServiceDate string
+		Time        time.Time
+	}
+
+	tests := []struct {
+		name     string
+		stopID   string
+		routeID  string
+		from, to time.Time
+		want     []dep
+	}{
+		{
+			name:   "morning",
+			stopID: "101",
+			from:   at(2, 18, 8, 0), to: at(2, 18, 8, 15),
+			want: []dep{
+				{"AFA19GEN-1037-Weekday-00_048000_1..S03R", "20200218", at(2, 18, 8, 0)},
+				{"AFA19GEN-1037-Weekday-00_049000_1..S01R", "20200218", at(2, 18, 8, 10)},
+				{"AFA19GEN-1037-Weekday-00_049000_1..S03R", "20200218", at(2, 18, 8, 10)},
+			},
+		},
+		{
+			name:   "platform",
+			stopID: "103S", routeID: "1",
+			from: at(2, 18, 8, 0), to: at(2, 18, 8, 10),
+			want: []dep{
+				{"AFA19GEN-1037-Weekday-00_048000_1..S03R", "20200218", at(2, 18, 8, 5)},
+			},
+		},
+		{
+			name:   "after midnight",
+			stopID: "101",
+			from:   at(2, 19, 0, 0), to: at(2, 19, 1, 0),
+			want: []dep{
+				{"AFA19GEN-1037-Weekday-00_147000_1..S03R", "20200218", at(2, 19, 0, 30)},
+			},
+		},
+		{
+			name:   "after midnight at a later stop",
+			stopID: "103", routeID: "1",
+			from: at(2, 19, 0, 0), to: at(2, 19, 1, 0),
+			want: []dep{
+				{"AFA19GEN-1037-Weekday-00_147000_1..S03R", "20200218", at(2, 19, 0, 35)},
+			},
+		},
+		{
+			name:   "friday night into saturday",
+			stopID: "101",
+			from:   at(2, 22, 0, 0), to: at(2, 22, 10, 0),
+			want: []dep{
+				{"AFA19GEN-1037-Weekday-00_147000_1..S03R", "20200221", at(2, 22, 0, 30)},
+				{"AFA19GEN-1037-Saturday-00_054000_1..S03R", "20200222", at(2, 22, 9, 0)},
+			},
+		},
+		{
+			// Presidents' Day runs a Sunday schedule, so no weekday
+			// trip runs past midnight into Tuesday
+			name:   "after a holiday",
+			stopID: "101",
+			from:   at(2, 18, 0, 0), to: at(2, 18, 1, 0),
+		},
+		{
+			name:   "end is exclusive",
+			stopID: "101",
+			from:   at(2, 18, 23, 0), to: at(2, 19, 0, 30),
+		},
+		{
+			name:   "other route",
+			stopID: "101", routeID: "2",
+			from: at(2, 18, 0, 0), to: at(2, 19, 0, 0),
+		},
+		{
+			name:   "terminal",
+			stopID: "104",
+			from:   at(2, 18, 0, 0), to: at(2, 19, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []dep
+			for _, d := range feed.ScheduledDepartures(tt.stopID, tt.routeID, AnyDirection, tt.from, tt.to) {
+				got = append(got, dep{d.Trip.ID, d.ServiceDate.Format(DateFormat), d.Time})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package static
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jprobinson/gtfs/geo"
+)
+
+// Length returns the length of the shape in meters.
+func (s Shape) Length() float64 {
+	var total float64
+	for i := 1; i < len(s.Points); i++ {
+		total += distance(s.Points[i-1], s.Points[i])
+	}
+	return total
+}
+
+// DistanceAlong will project a point onto the shape and return how far along
+// the shape it lies in meters, along with the distance from the point to the
+// shape.
+func (s Shape) DistanceAlong(lat, lon float64) (along, off float64) {
+	_, along, off = s.project(lat, lon, 0)
+	return along, off
+}
+
+// PointAt returns the position a number of meters along the shape. Distances
+// beyond either end are clamped to it.
+func (s Shape) PointAt(meters float64) (lat, lon float64) {
+	if len(s.Points) == 0 {
+		return 0, 0
+	}
+	for i := 1; i < len(s.Points); i++ {
+		a, b := s.Points[i-1], s.Points[i]
+		seg := distance(a, b)
+		if meters <= seg && seg > 0 {
+			f := math.Max(meters, 0) / seg
+			return a.Lat + f*(b.Lat-a.Lat), a.Lon + f*(b.Lon-a.Lon)
+		}
+		meters -= seg
+	}
+	last := s.Points[len(s.Points)-1]
+	return last.Lat, last.Lon
+}
+
+// project finds the closest point on the shape to lat/lon, starting at
+// segment from, and returns its segment and distance along the shape.
+func (s Shape) project(lat, lon float64, from int) (seg int, along, off float64) {
+	if len(s.Points) == 0 {
+		return 0, 0, 0
+	}
+	p := ShapePoint{Lat: lat, Lon: lon}
+	if len(s.Points) == 1 {
+		return 0, 0, distance(s.Points[0], p)
+	}
+
+	var walked float64
+	for i := 1; i < from && i < len(s.Points); i++ {
+		walked += distance(s.Points[i-1], s.Points[i])
+	}
+	off = math.Inf(1)
+	if from < 1 {
+		from = 1
+	}
+	for i := from; i < len(s.Points); i++ {
+		a, b := s.Points[i-1], s.Points[i]
+		segLen := distance(a, b)
+
+		// project on a flat plane around the point, which is plenty accurate
+		// over the length of a segment
+		kx := math.Cos(lat * math.Pi / 180)
+		ax, ay := (a.Lon-lon)*kx, a.Lat-lat
+		bx, by := (b.Lon-lon)*kx, b.Lat-lat
+		dx, dy := bx-ax, by-ay
+		var t float64
+		if l := dx*dx + dy*dy; l > 0 {
+			t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+		}
+		closest := ShapePoint{Lat: a.Lat + t*(b.Lat-a.Lat), Lon: a.Lon + t*(b.Lon-a.Lon)}
+		if d := distance(closest, p); d < off {
+			seg, along, off = i, walked+t*segLen, d
+		}
+		walked += segLen
+	}
+	return seg, along, off
+}
+
+// RouteShapes will return the shapes followed by a route's trips, the most
+// used first.
+func (f *Feed) RouteShapes(routeID string) []Shape {
+	counts := map[string]int{}
+	for _, trip := range f.Trips {
+		if trip.RouteID != routeID || trip.ShapeID == "" {
+			continue
+		}
+		if _, ok := f.Shapes[trip.ShapeID]; ok {
+			counts[trip.ShapeID]++
+		}
+	}
+
+	out := make([]Shape, 0, len(counts))
+	for id := range counts {
+		out = append(out, f.Shapes[id])
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if counts[out[i].ID] != counts[out[j].ID] {
+			return counts[out[i].ID] > counts[out[j].ID]
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// StopDistances will return how far along its shape, in meters, a trip is
+// at each of its stop times. Stops are matched in order so shapes that
+// double back on themselves resolve correctly. False is returned if the trip
+// has no shape.
+func (f *Feed) StopDistances(tripID string) ([]float64, bool) {
+	shape, ok := f.Shapes[f.Trips[tripID].ShapeID]
+	if !ok || len(shape.Points) == 0 {
+		return nil, false
+	}
+	sts := f.StopTimes[tripID]
+	out := make([]float64, len(sts))
+	seg := 0
+	for i, st := range sts {
+		stop := f.Stops[st.StopID]
+		seg, out[i], _ = shape.project(stop.Lat, stop.Lon, seg)
+	}
+	return out, true
+}
+
+// distance returns the great circle distance in meters between two points.
+func distance(a, b ShapePoint) float64 {
+	return geo.Distance(a.Lat, a.Lon, b.Lat, b.Lon)
+}
@@ -0,0 +1,161 @@
+package static
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jprobinson/gtfs/geo"
+)
+
+// the fixture's 1 shape runs 101 - bend - 103 - 104
+var (
+	testShapePoints = []ShapePoint{
+		{Lat: 40.889248, Lon: -73.898583},
+		{Lat: 40.887, Lon: -73.9005},
+		{Lat: 40.884667, Lon: -73.90087},
+		{Lat: 40.878856, Lon: -73.904834},
+	}
+	testShapeLegs = []float64{
+		geo.Distance(40.889248, -73.898583, 40.887, -73.9005),
+		geo.Distance(40.887, -73.9005, 40.884667, -73.90087),
+		geo.Distance(40.884667, -73.90087, 40.878856, -73.904834),
+	}
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestShapes(t *testing.T) {
+	feed := testFeed(t)
+
+	shape, ok := feed.Shapes["1..S03R"]
+	if !ok {
+		t.Fatal("expected shape 1..S03R")
+	}
+	// points are listed out of order in shapes.txt
+	if len(shape.Points) != len(testShapePoints) {
+		t.Fatalf("expected %d points, got %d", len(testShapePoints), len(shape.Points))
+	}
+	for i, pt := range shape.Points {
+		if pt.Sequence != i || pt.Lat != testShapePoints[i].Lat || pt.Lon != testShapePoints[i].Lon {
+			t.Errorf("point %d = %+v, want %+v", i, pt, testShapePoints[i])
+		}
+	}
+
+	total := testShapeLegs[0] + testShapeLegs[1] + testShapeLegs[2]
+	if got := shape.Length(); !near(got, total) {
+		t.Errorf("Length() = %f, want %f", got, total)
+	}
+
+	if got := feed.RouteShapes("1"); len(got) != 1 || got[0].ID != "1..S03R" {
+		t.Errorf("RouteShapes(1) = %+v, want only 1..S03R", got)
+	}
+	if got := feed.RouteShapes("2"); len(got) != 0 {
+		t.Errorf("RouteShapes(2) = %+v, want none", got)
+	}
+
+	dists, ok := feed.StopDistances("AFA19GEN-1037-Weekday-00_048000_1..S03R")
+	if !ok {
+		t.Fatal("expected stop distances for the 08:00 1")
+	}
+	want := []float64{0, testShapeLegs[0] + testShapeLegs[1], total}
+	if len(dists) != len(want) {
+		t.Fatalf("StopDistances = %v, want %v", dists, want)
+	}
+	for i := range want {
+		if !near(dists[i], want[i]) {
+			t.Errorf("StopDistances = %v, want %v", dists, want)
+			break
+		}
+	}
+	if _, ok := feed.StopDistances("AFA19GEN-1037-Weekday-00_049000_1..S01R"); ok {
+		t.Error("expected no stop distances for a trip with a missing shape")
+	}
+	if _, ok := feed.StopDistances("AFA19GEN-2047-Weekday-00_049200_2..S01R"); ok {
+		t.Error("expected no stop distances for a trip without a shape")
+	}
+}
+
+func TestShapeDistanceAlong(t *testing.T) {
+	shape := Shape{ID: "test", Points: testShapePoints}
+	total := testShapeLegs[0] + testShapeLegs[1] + testShapeLegs[2]
+
+	tests := []struct {
+		name      string
+		lat, lon  float64
+		wantAlong float64
+		wantOff   float64
+	}{
+		{"start", 40.889248, -73.898583, 0, 0},
+		{"bend", 40.887, -73.9005, testShapeLegs[0], 0},
+		{"end", 40.878856, -73.904834, total, 0},
+		{"past the end", 40.87, -73.904834, total, geo.Distance(40.87, -73.904834, 40.878856, -73.904834)},
+	}
+	for _, tt := range tests {
+		along, off := shape.DistanceAlong(tt.lat, tt.lon)
+		if !near(along, tt.wantAlong) || !near(off, tt.wantOff) {
+			t.Errorf("%s: DistanceAlong = %f, %f, want %f, %f", tt.name, along, off, tt.wantAlong, tt.wantOff)
+		}
+	}
+
+	// halfway down the last leg lies between 103 and 104 on the shape
+	along, off := shape.DistanceAlong((40.884667+40.878856)/2, (-73.90087+-73.904834)/2)
+	if mid := testShapeLegs[0] + testShapeLegs[1] + testShapeLegs[2]/2; math.Abs(along-mid) > 1 || off > 1 {
+		t.Errorf("DistanceAlong(midpoint) = %f, %f, want about %f, 0", along, off, mid)
+	}
+}
+
+func TestShapePointAt(t *testing.T) {
+	shape := Shape{ID: "test", Points: testShapePoints}
+	total := testShapeLegs[0] + testShapeLegs[1] + testShapeLegs[2]
+
+	tests := []struct {
+		name    string
+		meters  float64
+		wantLat float64
+		wantLon float64
+	}{
+		{"before the start", -100, 40.889248, -73.898583},
+		{"start", 0, 40.889248, -73.898583},
+		{"bend", testShapeLegs[0], 40.887, -73.9005},
+		{"halfway down the first leg", testShapeLegs[0] / 2, (40.889248 + 40.887) / 2, (-73.898583 + -73.9005) / 2},
+		{"end", total, 40.878856, -73.904834},
+		{"past the end", total + 100, 40.878856, -73.904834},
+	}
+	for _, tt := range tests {
+		lat, lon := shape.PointAt(tt.meters)
+		if !near(lat, tt.wantLat) || !near(lon, tt.wantLon) {
+			t.Errorf("%s: PointAt(%f) = %f, %f, want %f, %f", tt.name, tt.meters, lat, lon, tt.wantLat, tt.wantLon)
+		}
+	}
+
+	if lat, lon := (Shape{}).PointAt(10); lat != 0 || lon != 0 {
+		t.Errorf("empty PointAt = %f, %f, want 0, 0", lat, lon)
+	}
+}
+
+func TestShapeDoublesBack(t *testing.T) {
+	// out to the bend and back to the start
+	a, b := testShapePoints[0], testShapePoints[1]
+	shape := Shape{ID: "loop", Points: []ShapePoint{a, b, a}}
+
+	_, along, _ := shape.project(a.Lat, a.Lon, 0)
+	if along != 0 {
+		t.Errorf("project from the start = %f, want 0", along)
+	}
+	// searching from the second leg finds the return to the start
+	_, along, _ = shape.project(a.Lat, a.Lon, 2)
+	if want := 2 * testShapeLegs[0]; !near(along, want) {
+		t.Errorf("project from the second leg = %f, want %f", along, want)
+	}
+}
+
+func TestStationIDs(t *testing.T) {
+	feed := testFeed(t)
+	got := feed.StationIDs([]string{"101S", "103N", "104", "missing"})
+	if want := []string{"101", "103", "104", "missing"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StationIDs = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+package static
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Feed holds every file of a static GTFS feed.
+	Feed struct {
+		Agencies []Agency
+		// Location is the timezone of the feed's agencies.
+		Location *time.Location
+
+		// stop ID => stop
+		Stops map[string]Stop
+		// route ID => route
+		Routes map[string]Route
+		// trip ID => trip
+		Trips map[string]Trip
+		// trip ID => stop times ordered by stop sequence
+		StopTimes map[string][]StopTime
+		// service ID => calendar
+		Calendars map[string]Calendar
+		// service ID => exceptions
+		CalendarDates map[string][]CalendarDate
+
+		Transfers []Transfer
+		// shape ID => shape
+		Shapes map[string]Shape
+
+		indexOnce sync.Once
+		// stop ID => stop times at that stop
+		stopTimesByStop map[string][]StopTime
+
+		graphOnce sync.Once
+		graph     *TransferGraph
+	}
+
+	Agency struct {
+		ID       string
+		Name     string
+		URL      string
+		Timezone string
+		Lang     string
+		Phone    string
+	}
+
+	Stop struct {
+		ID   string
+		Code string
+		Name string
+		Desc string
+
+		Lat float64
+		Lon float64
+
+		ZoneID string
+		URL    string
+
+		LocationType  LocationType
+		ParentStation string
+	}
+
+	Route struct {
+		ID        string
+		AgencyID  string
+		ShortName string
+		LongName  string
+		Desc      string
+		Type      RouteType
+		URL       string
+		Color     string
+		TextColor string
+	}
+
+	Trip struct {
+		ID        string
+		RouteID   string
+		ServiceID string
+		Headsign  string
+
+		DirectionID int
+
+		BlockID string
+		ShapeID string
+	}
+
+	StopTime struct {
+		TripID string
+		StopID string
+
+		ArrivalTime   Time
+		DepartureTime Time
+
+		StopSequence int
+		StopHeadsign string
+
+		PickupType  int
+		DropOffType int
+
+		ShapeDistTraveled float64
+	}
+
+	Calendar struct {
+		ServiceID string
+
+		// indexed by time.Weekday
+		Days [7]bool
+
+		StartDate time.Time
+		EndDate   time.Time
+	}
+
+	CalendarDate struct {
+		ServiceID     string
+		Date          time.Time
+		ExceptionType ExceptionType
+	}
+
+	Transfer struct {
+		FromStopID string
+		ToStopID   string
+
+		Type            TransferType
+		MinTransferTime time.Duration
+	}
+
+	// Shape is the path vehicles travel, ordered by sequence.
+	Shape struct {
+		ID     string
+		Points []ShapePoint
+	}
+
+	ShapePoint struct {
+		Lat      float64
+		Lon      float64
+		Sequence int
+		// DistTraveled is shape_dist_traveled, in the feed's own units.
+		DistTraveled float64
+	}
+)
+
+type LocationType int
+
+const (
+	LocationStop LocationType = iota
+	LocationStation
+	LocationEntrance
+)
+
+type RouteType int
+
+const (
+	RouteTram RouteType = iota
+	RouteSubway
+	RouteRail
+	RouteBus
+	RouteFerry
+)
+
+type ExceptionType int
+
+const (
+	ServiceAdded   ExceptionType = 1
+	ServiceRemoved ExceptionType = 2
+)
+
+type TransferType int
+
+const (
+	TransferRecommended TransferType = iota
+	TransferTimed
+	TransferMinTime
+	TransferNotPossible
+)
@@ -0,0 +1,67 @@
+package static
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFormat is the layout GTFS uses for service dates.
+const DateFormat = "20060102"
+
+// Time is a GTFS stop time: the offset from "noon minus 12h" on a service
+// day. Trips that run past midnight have times beyond 24h.
+type Time time.Duration
+
+// NoTime marks a stop time that was left blank in stop_times.txt.
+const NoTime Time = -1
+
+// ParseTime parses an HH:MM:SS stop time. Hours may exceed 23.
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return NoTime, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return NoTime, fmt.Errorf("invalid time %q", s)
+	}
+	var secs [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || (i > 0 && n > 59) {
+			return NoTime, fmt.Errorf("invalid time %q", s)
+		}
+		secs[i] = n
+	}
+	return Time(time.Duration(secs[0])*time.Hour +
+		time.Duration(secs[1])*time.Minute +
+		time.Duration(secs[2])*time.Second), nil
+}
+
+// String formats t as HH:MM:SS.
+func (t Time) String() string {
+	if t == NoTime {
+		return ""
+	}
+	secs := int(time.Duration(t) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+}
+
+// On returns the absolute time of t on the given service date. The date's
+// location should be the agency's timezone so daylight saving days resolve
+// correctly.
+func (t Time) On(date time.Time) time.Time {
+	y, m, d := date.Date()
+	noon := time.Date(y, m, d, 12, 0, 0, 0, date.Location())
+	return noon.Add(time.Duration(t) - 12*time.Hour)
+}
+
+// ParseDate parses a YYYYMMDD service date.
+func ParseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("missing date")
+	}
+	return time.Parse(DateFormat, s)
+}
@@ -0,0 +1,78 @@
+package static
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"08:00:00", 8 * time.Hour, false},
+		{"23:59:59", 24*time.Hour - time.Second, false},
+		{"24:00:00", 24 * time.Hour, false},
+		{"24:30:00", 24*time.Hour + 30*time.Minute, false},
+		{"47:59:59", 48*time.Hour - time.Second, false},
+		{"8:05:00", 8*time.Hour + 5*time.Minute, false},
+		{"", time.Duration(NoTime), false},
+		{"08:60:00", 0, true},
+		{"08:00", 0, true},
+		{"-1:00:00", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTime(%q) error = %v, want error: %t", tt.in, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if time.Duration(got) != tt.want {
+			t.Errorf("ParseTime(%q) = %s, want %s", tt.in, time.Duration(got), tt.want)
+		}
+		if tt.in != "" && len(tt.in) == 8 && got.String() != tt.in {
+			t.Errorf("ParseTime(%q).String() = %q", tt.in, got.String())
+		}
+	}
+}
+
+func TestTimeOn(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		date time.Time
+		want time.Time
+	}{
+		{"morning", "08:00:00", day(2020, 2, 18), time.Date(2020, 2, 18, 8, 0, 0, 0, loc)},
+		{"midnight", "24:00:00", day(2020, 2, 18), time.Date(2020, 2, 19, 0, 0, 0, 0, loc)},
+		{"after midnight", "24:30:00", day(2020, 2, 18), time.Date(2020, 2, 19, 0, 30, 0, 0, loc)},
+		{"second night", "47:00:00", day(2020, 2, 18), time.Date(2020, 2, 19, 23, 0, 0, 0, loc)},
+		// GTFS times count from noon minus 12h, so they stay on the
+		// wall clock across the change
+		{"spring forward", "08:00:00", day(2020, 3, 8), time.Date(2020, 3, 8, 8, 0, 0, 0, loc)},
+		{"fall back", "08:00:00", day(2020, 11, 1), time.Date(2020, 11, 1, 8, 0, 0, 0, loc)},
+		{"after midnight before spring forward", "25:00:00", day(2020, 3, 7),
+			time.Date(2020, 3, 8, 1, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		st, err := ParseTime(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := st.On(tt.date); !got.Equal(tt.want) {
+			t.Errorf("%s: %s on %s = %s, want %s", tt.name, tt.in, tt.date.Format(DateFormat), got, tt.want)
+		}
+	}
+}
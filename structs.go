@@ -7,7 +7,30 @@ type (
 		Northbound string
 		Southbound string
 
+		// Stops are the stops of the route's longest pattern in southbound
+		// order. Stations only its other patterns serve are in Patterns.
 		Stops []Stop
+		// Patterns are the distinct stop sequences the route runs, busiest
+		// first.
+		Patterns []Pattern `json:",omitempty"`
+	}
+
+	Pattern struct {
+		Name     string
+		Headsign string
+		// Direction is "N" or "S".
+		Direction string
+
+		// StopIDs are the stations served in order of travel.
+		StopIDs []string
+
+		// Days are the days of the week the pattern runs, indexed by
+		// time.Weekday.
+		Days [7]bool
+		// Bands are the times of day trips start, like "am rush" or
+		// "late night".
+		Bands []string
+		Trips int
 	}
 
 	Stop struct {
@@ -17,6 +40,9 @@ type (
 		DisplayName  string
 		PhoneticName string
 
+		Lat float64
+		Lon float64
+
 		Synonyms  []string
 		Transfers []Transfer `json:",omitempty"`
 	}
@@ -24,6 +50,12 @@ type (
 	Transfer struct {
 		StopID string
 		Route  string
+
+		// Type is the transfer_type and MinTransferSeconds the
+		// min_transfer_time from transfers.txt, the seconds needed to walk
+		// between the stops.
+		Type               int `json:",omitempty"`
+		MinTransferSeconds int
 	}
 
 	Synonym struct {